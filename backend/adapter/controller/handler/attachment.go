@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IAttachmentHandler interface {
+	GetTaskAttachments(c *gin.Context, id int)
+	UploadAttachment(c *gin.Context, id int)
+	DownloadAttachment(c *gin.Context, id int, attachmentId int)
+	DeleteAttachment(c *gin.Context, id int, attachmentId int)
+}
+
+type attachmentHandler struct {
+	au usecase.IAttachmentUsecase
+}
+
+func NewAttachmentHandler(au usecase.IAttachmentUsecase) IAttachmentHandler {
+	return &attachmentHandler{au: au}
+}
+
+func attachmentToData(attachment *entity.Attachment) presenter.Attachment {
+	return presenter.Attachment{
+		Kind:        "attachment",
+		Id:          int(attachment.ID),
+		TaskId:      int(attachment.TaskID),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func attachmentToResponse(attachment *entity.Attachment) presenter.AttachmentResponse {
+	return presenter.AttachmentResponse{
+		ApiVersion: api.Version,
+		Data:       attachmentToData(attachment),
+	}
+}
+
+func attachmentsToResponse(attachments *[]entity.Attachment) presenter.AttachmentsResponse {
+	data := make([]presenter.Attachment, len(*attachments))
+	for i, attachment := range *attachments {
+		data[i] = attachmentToData(&attachment)
+	}
+	return presenter.AttachmentsResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+	}
+}
+
+// contentDisposition は日本語などのファイル名も RFC 6266 に沿ってエンコードした Content-Disposition を返す
+func contentDisposition(fileName string) string {
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+	if disposition == "" {
+		return "attachment"
+	}
+	return disposition
+}
+
+func (ah *attachmentHandler) GetTaskAttachments(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	attachments, err := ah.au.GetAll(entity.TaskID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, attachmentsToResponse(attachments))
+}
+
+func (ah *attachmentHandler) UploadAttachment(c *gin.Context, id int) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusRequestEntityTooLarge, entity.ErrAttachmentTooLarge.Error()))
+			return
+		}
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	defer file.Close()
+
+	attachment := &entity.Attachment{
+		TaskID:   entity.TaskID(id),
+		UserID:   userID,
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+	}
+
+	createdAttachment, err := ah.au.Upload(c.Request.Context(), attachment, file)
+	if err != nil {
+		if errors.Is(err, entity.ErrTaskNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrAttachmentTooLarge) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusRequestEntityTooLarge, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrAttachmentTypeNotAllowed) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusUnsupportedMediaType, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachmentToResponse(createdAttachment))
+}
+
+func (ah *attachmentHandler) DownloadAttachment(c *gin.Context, id int, attachmentId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	attachment, body, err := ah.au.Download(c.Request.Context(), entity.AttachmentID(attachmentId), entity.TaskID(id), userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrAttachmentNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	defer body.Close()
+
+	// 中身はメモリに載せずにストレージからそのまま流す
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, body, map[string]string{
+		"Content-Disposition":    contentDisposition(attachment.FileName),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func (ah *attachmentHandler) DeleteAttachment(c *gin.Context, id int, attachmentId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := ah.au.Delete(c.Request.Context(), entity.AttachmentID(attachmentId), entity.TaskID(id), userID); err != nil {
+		if errors.Is(err, entity.ErrAttachmentNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IChecklistHandler interface {
+	CreateChecklistItem(c *gin.Context, id int)
+	UpdateChecklistItem(c *gin.Context, id int, itemId int)
+	CheckChecklistItem(c *gin.Context, id int, itemId int)
+	UncheckChecklistItem(c *gin.Context, id int, itemId int)
+	ReorderChecklistItems(c *gin.Context, id int)
+	DeleteChecklistItem(c *gin.Context, id int, itemId int)
+}
+
+type checklistHandler struct {
+	cu usecase.IChecklistUsecase
+}
+
+func NewChecklistHandler(cu usecase.IChecklistUsecase) IChecklistHandler {
+	return &checklistHandler{cu: cu}
+}
+
+func checklistItemToData(item *entity.ChecklistItem) presenter.ChecklistItem {
+	return presenter.ChecklistItem{
+		Kind:     "checklistItem",
+		Id:       int(item.ID),
+		Text:     item.Text,
+		Checked:  item.Checked,
+		Position: item.Position,
+	}
+}
+
+func checklistItemsToData(items []entity.ChecklistItem) []presenter.ChecklistItem {
+	data := make([]presenter.ChecklistItem, len(items))
+	for i, item := range items {
+		data[i] = checklistItemToData(&item)
+	}
+	return data
+}
+
+func checklistItemToResponse(item *entity.ChecklistItem) presenter.ChecklistItemResponse {
+	return presenter.ChecklistItemResponse{
+		ApiVersion: api.Version,
+		Data:       checklistItemToData(item),
+	}
+}
+
+func checklistItemsToResponse(items *[]entity.ChecklistItem) presenter.ChecklistItemsResponse {
+	return presenter.ChecklistItemsResponse{
+		ApiVersion: api.Version,
+		Data:       checklistItemsToData(*items),
+	}
+}
+
+func (ch *checklistHandler) CreateChecklistItem(c *gin.Context, id int) {
+	var requestBody presenter.CreateChecklistItemRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	item := &entity.ChecklistItem{
+		TaskID: entity.TaskID(id),
+		Text:   requestBody.Text,
+	}
+
+	createdItem, err := ch.cu.Create(item, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTaskNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, checklistItemToResponse(createdItem))
+}
+
+func (ch *checklistHandler) UpdateChecklistItem(c *gin.Context, id int, itemId int) {
+	var requestBody presenter.UpdateChecklistItemRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	item := &entity.ChecklistItem{
+		ID:     entity.ChecklistItemID(itemId),
+		TaskID: entity.TaskID(id),
+		Text:   requestBody.Text,
+	}
+
+	updatedItem, err := ch.cu.Save(item, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, checklistItemToResponse(updatedItem))
+}
+
+func (ch *checklistHandler) CheckChecklistItem(c *gin.Context, id int, itemId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	item, err := ch.cu.Check(entity.ChecklistItemID(itemId), entity.TaskID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, checklistItemToResponse(item))
+}
+
+func (ch *checklistHandler) UncheckChecklistItem(c *gin.Context, id int, itemId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	item, err := ch.cu.Uncheck(entity.ChecklistItemID(itemId), entity.TaskID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, checklistItemToResponse(item))
+}
+
+func (ch *checklistHandler) ReorderChecklistItems(c *gin.Context, id int) {
+	var requestBody presenter.ReorderChecklistItemsRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	itemIDs := make([]entity.ChecklistItemID, len(requestBody.ItemIds))
+	for i, itemID := range requestBody.ItemIds {
+		itemIDs[i] = entity.ChecklistItemID(itemID)
+	}
+
+	items, err := ch.cu.Reorder(entity.TaskID(id), userID, itemIDs)
+	if err != nil {
+		if errors.Is(err, entity.ErrChecklistOrderMismatch) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, checklistItemsToResponse(items))
+}
+
+func (ch *checklistHandler) DeleteChecklistItem(c *gin.Context, id int, itemId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := ch.cu.Delete(entity.ChecklistItemID(itemId), entity.TaskID(id), userID); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
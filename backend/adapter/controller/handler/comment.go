@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/pkg/markdown"
+	"backend/usecase"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ICommentHandler interface {
+	GetTaskComments(c *gin.Context, id int)
+	CreateComment(c *gin.Context, id int)
+	UpdateComment(c *gin.Context, id int, commentId int)
+	DeleteComment(c *gin.Context, id int, commentId int)
+}
+
+type commentHandler struct {
+	cu usecase.ICommentUsecase
+}
+
+func NewCommentHandler(cu usecase.ICommentUsecase) ICommentHandler {
+	return &commentHandler{cu: cu}
+}
+
+func bodyToHTML(s string) string {
+	html, err := markdown.ToSafeHTML(s)
+	if err != nil {
+		logger.Warn("Failed to render comment: " + err.Error())
+		return ""
+	}
+	return html
+}
+
+func commentToData(comment *entity.Comment) presenter.Comment {
+	return presenter.Comment{
+		Kind:      "comment",
+		Id:        int(comment.ID),
+		TaskId:    int(comment.TaskID),
+		UserId:    int(comment.UserID),
+		Body:      comment.Body,
+		BodyHtml:  bodyToHTML(comment.Body),
+		CreatedAt: comment.CreatedAt,
+		EditedAt:  comment.EditedAt,
+	}
+}
+
+func commentToResponse(comment *entity.Comment) presenter.CommentResponse {
+	return presenter.CommentResponse{
+		ApiVersion: api.Version,
+		Data:       commentToData(comment),
+	}
+}
+
+func commentsToResponse(comments *[]entity.Comment) presenter.CommentsResponse {
+	data := make([]presenter.Comment, len(*comments))
+	for i, comment := range *comments {
+		data[i] = commentToData(&comment)
+	}
+	return presenter.CommentsResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+	}
+}
+
+func (ch *commentHandler) GetTaskComments(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	comments, err := ch.cu.GetAll(entity.TaskID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, commentsToResponse(comments))
+}
+
+func (ch *commentHandler) CreateComment(c *gin.Context, id int) {
+	var requestBody presenter.CreateCommentRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	comment := &entity.Comment{
+		TaskID: entity.TaskID(id),
+		UserID: userID,
+		Body:   requestBody.Body,
+	}
+
+	createdComment, err := ch.cu.Create(comment)
+	if err != nil {
+		if errors.Is(err, entity.ErrTaskNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, commentToResponse(createdComment))
+}
+
+func (ch *commentHandler) UpdateComment(c *gin.Context, id int, commentId int) {
+	var requestBody presenter.UpdateCommentRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	comment := &entity.Comment{
+		ID:     entity.CommentID(commentId),
+		TaskID: entity.TaskID(id),
+		UserID: userID,
+		Body:   requestBody.Body,
+	}
+
+	updatedComment, err := ch.cu.Save(comment)
+	if err != nil {
+		if errors.Is(err, entity.ErrCommentNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, commentToResponse(updatedComment))
+}
+
+func (ch *commentHandler) DeleteComment(c *gin.Context, id int, commentId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := ch.cu.Delete(entity.CommentID(commentId), entity.TaskID(id), userID); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
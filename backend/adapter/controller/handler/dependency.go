@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IDependencyHandler interface {
+	CreateTaskDependency(c *gin.Context, id int)
+	DeleteTaskDependency(c *gin.Context, id int, blockerId int)
+}
+
+type dependencyHandler struct {
+	du usecase.IDependencyUsecase
+}
+
+func NewDependencyHandler(du usecase.IDependencyUsecase) IDependencyHandler {
+	return &dependencyHandler{du: du}
+}
+
+func blockedByToData(task *entity.Task) []int {
+	ids := task.BlockedByIDs()
+	data := make([]int, len(ids))
+	for i, id := range ids {
+		data[i] = int(id)
+	}
+	return data
+}
+
+func (dh *dependencyHandler) CreateTaskDependency(c *gin.Context, id int) {
+	var requestBody presenter.CreateTaskDependencyRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	dependency := &entity.TaskDependency{
+		TaskID:    entity.TaskID(id),
+		BlockerID: entity.TaskID(requestBody.BlockerId),
+	}
+
+	task, err := dh.du.Create(dependency, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrDependencyTaskNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrDependencyCycle) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusConflict, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	c.JSON(http.StatusCreated, taskToResponse(task))
+}
+
+func (dh *dependencyHandler) DeleteTaskDependency(c *gin.Context, id int, blockerId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := dh.du.Delete(entity.TaskID(id), entity.TaskID(blockerId), userID); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
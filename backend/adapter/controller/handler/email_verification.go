@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IEmailVerificationHandler interface {
+	PostVerifyEmail(c *gin.Context)
+	PostVerifyEmailResend(c *gin.Context)
+}
+
+type emailVerificationHandler struct {
+	eu usecase.IEmailVerificationUsecase
+}
+
+func NewEmailVerificationHandler(eu usecase.IEmailVerificationUsecase) IEmailVerificationHandler {
+	return &emailVerificationHandler{eu: eu}
+}
+
+func (eh *emailVerificationHandler) PostVerifyEmail(c *gin.Context) {
+	var requestBody presenter.VerifyEmailRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	if err := eh.eu.Verify(requestBody.Token); err != nil {
+		if errors.Is(err, entity.ErrInvalidEmailVerificationToken) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (eh *emailVerificationHandler) PostVerifyEmailResend(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := eh.eu.Resend(userID); err != nil {
+		if errors.Is(err, entity.ErrEmailAlreadyVerified) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrEmailVerificationThrottled) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusTooManyRequests, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
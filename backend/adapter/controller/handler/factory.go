@@ -10,6 +10,19 @@ var (
 type ServerHandler struct {
 	IUserHandler
 	ITaskHandler
+	ITagHandler
+	IProjectHandler
+	IChecklistHandler
+	ICommentHandler
+	IAttachmentHandler
+	ITimeEntryHandler
+	IReportHandler
+	IViewHandler
+	IDependencyHandler
+	ITrashHandler
+	ISessionHandler
+	IPasswordHandler
+	IEmailVerificationHandler
 	ICsrfHandler
 }
 
@@ -26,6 +39,32 @@ func (h *ServerHandler) Register(i any) *ServerHandler {
 		serverHandler.IUserHandler = interfaceType
 	case ITaskHandler:
 		serverHandler.ITaskHandler = interfaceType
+	case ITagHandler:
+		serverHandler.ITagHandler = interfaceType
+	case IProjectHandler:
+		serverHandler.IProjectHandler = interfaceType
+	case IChecklistHandler:
+		serverHandler.IChecklistHandler = interfaceType
+	case ICommentHandler:
+		serverHandler.ICommentHandler = interfaceType
+	case IAttachmentHandler:
+		serverHandler.IAttachmentHandler = interfaceType
+	case ITimeEntryHandler:
+		serverHandler.ITimeEntryHandler = interfaceType
+	case IReportHandler:
+		serverHandler.IReportHandler = interfaceType
+	case IViewHandler:
+		serverHandler.IViewHandler = interfaceType
+	case IDependencyHandler:
+		serverHandler.IDependencyHandler = interfaceType
+	case ITrashHandler:
+		serverHandler.ITrashHandler = interfaceType
+	case ISessionHandler:
+		serverHandler.ISessionHandler = interfaceType
+	case IPasswordHandler:
+		serverHandler.IPasswordHandler = interfaceType
+	case IEmailVerificationHandler:
+		serverHandler.IEmailVerificationHandler = interfaceType
 	case ICsrfHandler:
 		serverHandler.ICsrfHandler = interfaceType
 	}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IPasswordHandler interface {
+	PostPasswordForgot(c *gin.Context)
+	PostPasswordReset(c *gin.Context)
+}
+
+type passwordHandler struct {
+	pu usecase.IPasswordUsecase
+}
+
+func NewPasswordHandler(pu usecase.IPasswordUsecase) IPasswordHandler {
+	return &passwordHandler{pu: pu}
+}
+
+func (ph *passwordHandler) PostPasswordForgot(c *gin.Context) {
+	var requestBody presenter.PasswordForgotRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	if err := ph.pu.Forgot(requestBody.Email); err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	// 登録されているかどうかにかかわらず同じレスポンスを返す
+	c.Status(http.StatusAccepted)
+}
+
+func (ph *passwordHandler) PostPasswordReset(c *gin.Context) {
+	var requestBody presenter.PasswordResetRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	if err := ph.pu.Reset(requestBody.Token, requestBody.Password); err != nil {
+		if errors.Is(err, entity.ErrInvalidPasswordResetToken) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IProjectHandler interface {
+	CreateProject(c *gin.Context)
+	GetProjectById(c *gin.Context, id int)
+	GetAllProjects(c *gin.Context, params presenter.GetAllProjectsParams)
+	UpdateProjectById(c *gin.Context, id int)
+	ArchiveProject(c *gin.Context, id int)
+	UnarchiveProject(c *gin.Context, id int)
+	DeleteProjectById(c *gin.Context, id int, params presenter.DeleteProjectByIdParams)
+}
+
+type projectHandler struct {
+	pu usecase.IProjectUsecase
+}
+
+func NewProjectHandler(pu usecase.IProjectUsecase) IProjectHandler {
+	return &projectHandler{pu: pu}
+}
+
+func projectToData(project *entity.Project) presenter.Project {
+	return presenter.Project{
+		Kind:     "project",
+		Id:       int(project.ID),
+		Name:     project.Name,
+		Color:    presenter.Color(project.Color),
+		Archived: project.Archived,
+	}
+}
+
+func projectToResponse(project *entity.Project) presenter.ProjectResponse {
+	return presenter.ProjectResponse{
+		ApiVersion: api.Version,
+		Data:       projectToData(project),
+	}
+}
+
+func projectsToResponse(projects *[]entity.Project) presenter.ProjectsResponse {
+	data := make([]presenter.Project, len(*projects))
+	for i, project := range *projects {
+		data[i] = projectToData(&project)
+	}
+	return presenter.ProjectsResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+	}
+}
+
+func colorToEntity(c *presenter.Color) (entity.Color, error) {
+	if c == nil {
+		return "", nil
+	}
+	color, err := entity.NewColor(*c)
+	if err != nil {
+		return "", err
+	}
+	return *color, nil
+}
+
+func (ph *projectHandler) CreateProject(c *gin.Context) {
+	var requestBody presenter.CreateProjectRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	color, err := colorToEntity(requestBody.Color)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	project := &entity.Project{
+		Name:   requestBody.Name,
+		Color:  color,
+		UserID: userID,
+	}
+
+	createdProject, err := ph.pu.Create(project)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, projectToResponse(createdProject))
+}
+
+func (ph *projectHandler) GetProjectById(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	project, err := ph.pu.Get(entity.ProjectID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, projectToResponse(project))
+}
+
+func (ph *projectHandler) GetAllProjects(c *gin.Context, params presenter.GetAllProjectsParams) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	includeArchived := params.IncludeArchived != nil && *params.IncludeArchived
+
+	projects, err := ph.pu.GetAll(userID, includeArchived)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, projectsToResponse(projects))
+}
+
+func (ph *projectHandler) UpdateProjectById(c *gin.Context, id int) {
+	var requestBody presenter.UpdateProjectRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	color, err := colorToEntity(requestBody.Color)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	project := &entity.Project{
+		ID:     entity.ProjectID(id),
+		Color:  color,
+		UserID: userID,
+	}
+	if requestBody.Name != nil {
+		project.Name = *requestBody.Name
+	}
+
+	updatedProject, err := ph.pu.Save(project)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, projectToResponse(updatedProject))
+}
+
+func (ph *projectHandler) ArchiveProject(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	project, err := ph.pu.Archive(entity.ProjectID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, projectToResponse(project))
+}
+
+func (ph *projectHandler) UnarchiveProject(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	project, err := ph.pu.Unarchive(entity.ProjectID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, projectToResponse(project))
+}
+
+func (ph *projectHandler) DeleteProjectById(c *gin.Context, id int, params presenter.DeleteProjectByIdParams) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	mode := entity.DeleteModeInbox
+	if params.Mode != nil {
+		if err := mode.Set(string(*params.Mode)); err != nil {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+	}
+
+	if err := ph.pu.Delete(entity.ProjectID(id), userID, mode); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
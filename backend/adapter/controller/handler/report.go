@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type IReportHandler interface {
+	GetEstimateReport(c *gin.Context, params presenter.GetEstimateReportParams)
+}
+
+type reportHandler struct {
+	ru usecase.IReportUsecase
+}
+
+func NewReportHandler(ru usecase.IReportUsecase) IReportHandler {
+	return &reportHandler{ru: ru}
+}
+
+func estimateUnitToData(unit *entity.EstimateUnit) *presenter.EstimateUnit {
+	if unit == nil {
+		return nil
+	}
+	estimateUnit := presenter.EstimateUnit(*unit)
+	return &estimateUnit
+}
+
+// paramsToEstimateReportQuery は日付で指定された期間を、最終日の翌日までの半開区間にする
+func paramsToEstimateReportQuery(params presenter.GetEstimateReportParams) *entity.EstimateReportQuery {
+	query := &entity.EstimateReportQuery{}
+	if params.From != nil {
+		query.From = params.From.Time
+	}
+	if params.To != nil {
+		query.To = params.To.Time.AddDate(0, 0, 1)
+	}
+	return query
+}
+
+func estimateReportToResponse(query *entity.EstimateReportQuery, rows *[]entity.EstimateReportRow) presenter.EstimateReportResponse {
+	data := make([]presenter.EstimateReportRow, len(*rows))
+	for i, row := range *rows {
+		data[i] = presenter.EstimateReportRow{
+			UserId:           int(row.UserID),
+			WeekStart:        openapi_types.Date{Time: row.WeekStart},
+			Unit:             estimateUnitToData(row.Unit),
+			TaskCount:        row.TaskCount,
+			EstimateTotal:    row.EstimateTotal,
+			CycleTimeSeconds: row.CycleSeconds,
+			TrackedSeconds:   row.TrackedSeconds,
+		}
+	}
+	return presenter.EstimateReportResponse{
+		ApiVersion: api.Version,
+		Data: presenter.EstimateReport{
+			Kind: "estimateReport",
+			From: openapi_types.Date{Time: query.From},
+			To:   openapi_types.Date{Time: query.To.AddDate(0, 0, -1)},
+			Rows: data,
+		},
+	}
+}
+
+func (rh *reportHandler) GetEstimateReport(c *gin.Context, params presenter.GetEstimateReportParams) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	query := paramsToEstimateReportQuery(params)
+	rows, err := rh.ru.GetEstimateReport(userID, query)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidReportPeriod) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, estimateReportToResponse(query, rows))
+}
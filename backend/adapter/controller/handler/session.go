@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ISessionHandler interface {
+	GetSessions(c *gin.Context)
+	DeleteSessionById(c *gin.Context, id int)
+}
+
+type sessionHandler struct {
+	su usecase.ISessionUsecase
+}
+
+func NewSessionHandler(su usecase.ISessionUsecase) ISessionHandler {
+	return &sessionHandler{su: su}
+}
+
+// getSessionIDFromContext はリクエストに使われたセッションの ID を返す。セッションに紐づかないトークンなら false を返す
+func getSessionIDFromContext(c *gin.Context) (entity.SessionID, bool) {
+	sessionID, exists := c.Get("session_id")
+	if !exists {
+		return 0, false
+	}
+	sessionIDFloat, ok := sessionID.(float64)
+	if !ok {
+		return 0, false
+	}
+	return entity.SessionID(sessionIDFloat), true
+}
+
+func sessionToData(session *entity.Session, currentID entity.SessionID) presenter.Session {
+	return presenter.Session{
+		Kind:       "session",
+		Id:         int(session.ID),
+		Device:     session.Device,
+		Current:    session.ID == currentID,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		ExpiresAt:  session.ExpiresAt,
+	}
+}
+
+func (sh *sessionHandler) GetSessions(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	sessions, err := sh.su.GetAll(userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	currentID, _ := getSessionIDFromContext(c)
+	data := make([]presenter.Session, len(*sessions))
+	for i, session := range *sessions {
+		data[i] = sessionToData(&session, currentID)
+	}
+	c.JSON(http.StatusOK, presenter.SessionsResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+	})
+}
+
+func (sh *sessionHandler) DeleteSessionById(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := sh.su.Revoke(entity.SessionID(id), userID); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ITagHandler interface {
+	CreateTag(c *gin.Context)
+	GetTagById(c *gin.Context, id int)
+	GetAllTags(c *gin.Context)
+	UpdateTagById(c *gin.Context, id int)
+	DeleteTagById(c *gin.Context, id int)
+}
+
+type tagHandler struct {
+	tgu usecase.ITagUsecase
+}
+
+func NewTagHandler(tgu usecase.ITagUsecase) ITagHandler {
+	return &tagHandler{tgu: tgu}
+}
+
+func tagToData(tag *entity.Tag) presenter.Tag {
+	return presenter.Tag{
+		Kind: "tag",
+		Id:   int(tag.ID),
+		Name: tag.Name,
+	}
+}
+
+func tagsToData(tags []entity.Tag) []presenter.Tag {
+	data := make([]presenter.Tag, len(tags))
+	for i, tag := range tags {
+		data[i] = tagToData(&tag)
+	}
+	return data
+}
+
+func tagToResponse(tag *entity.Tag) presenter.TagResponse {
+	return presenter.TagResponse{
+		ApiVersion: api.Version,
+		Data:       tagToData(tag),
+	}
+}
+
+func tagsToResponse(tags *[]entity.Tag) presenter.TagsResponse {
+	return presenter.TagsResponse{
+		ApiVersion: api.Version,
+		Data:       tagsToData(*tags),
+	}
+}
+
+func (tgh *tagHandler) CreateTag(c *gin.Context) {
+	var requestBody presenter.CreateTagRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	tag := &entity.Tag{
+		Name:   requestBody.Name,
+		UserID: userID,
+	}
+
+	createdTag, err := tgh.tgu.Create(tag)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, tagToResponse(createdTag))
+}
+
+func (tgh *tagHandler) GetTagById(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	tag, err := tgh.tgu.Get(entity.TagID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, tagToResponse(tag))
+}
+
+func (tgh *tagHandler) GetAllTags(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	tags, err := tgh.tgu.GetAll(userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, tagsToResponse(tags))
+}
+
+func (tgh *tagHandler) UpdateTagById(c *gin.Context, id int) {
+	var requestBody presenter.UpdateTagRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	tag := &entity.Tag{
+		ID:     entity.TagID(id),
+		Name:   requestBody.Name,
+		UserID: userID,
+	}
+
+	updatedTag, err := tgh.tgu.Save(tag)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, tagToResponse(updatedTag))
+}
+
+func (tgh *tagHandler) DeleteTagById(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := tgh.tgu.Delete(entity.TagID(id), userID); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -5,7 +5,9 @@ import (
 	"backend/api"
 	"backend/entity"
 	"backend/pkg/logger"
+	"backend/pkg/markdown"
 	"backend/usecase"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -16,8 +18,13 @@ import (
 type ITaskHandler interface {
 	CreateTask(c *gin.Context)
 	GetTaskById(c *gin.Context, id int)
-	GetAllTasks(c *gin.Context)
+	GetAllTasks(c *gin.Context, params presenter.GetAllTasksParams)
+	SearchTasks(c *gin.Context, params presenter.SearchTasksParams)
 	UpdateTaskById(c *gin.Context, id int)
+	MoveTask(c *gin.Context, id int)
+	MoveTaskToProject(c *gin.Context, id int)
+	SnoozeTask(c *gin.Context, id int)
+	GetTaskHistory(c *gin.Context, id int, params presenter.GetTaskHistoryParams)
 	DeleteTaskById(c *gin.Context, id int)
 }
 
@@ -33,7 +40,7 @@ func timeToDeadline(t *time.Time) *presenter.Deadline {
 	if t == nil {
 		return nil
 	}
-	deadline := presenter.Deadline{Time: *t}
+	deadline := presenter.Deadline{Time: entity.DateOf(*t)}
 	return &deadline
 }
 
@@ -45,16 +52,173 @@ func deadlineToTime(d *presenter.Deadline) *time.Time {
 	return &time
 }
 
+func optionalString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func stringToOptional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func timeToUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func descriptionToHTML(s string) *string {
+	if s == "" {
+		return nil
+	}
+	html, err := markdown.ToSafeHTML(s)
+	if err != nil {
+		logger.Warn("Failed to render description: " + err.Error())
+		return nil
+	}
+	return &html
+}
+
+func tagIDsToTags(ids *[]int) []entity.Tag {
+	if ids == nil {
+		return nil
+	}
+	tags := make([]entity.Tag, len(*ids))
+	for i, id := range *ids {
+		tags[i] = entity.Tag{ID: entity.TagID(id)}
+	}
+	return tags
+}
+
+func recurrenceToEntity(r *presenter.Recurrence) (*entity.RecurrenceRule, error) {
+	if r == nil {
+		return nil, nil
+	}
+	return entity.ParseRecurrenceRule(*r)
+}
+
+func recurrenceToData(rule *entity.RecurrenceRule) *presenter.Recurrence {
+	if rule == nil {
+		return nil
+	}
+	recurrence := rule.String()
+	return &recurrence
+}
+
+func estimateToEntity(e *presenter.Estimate) (*entity.Estimate, error) {
+	if e == nil {
+		return nil, nil
+	}
+	return entity.NewEstimate(e.Value, string(e.Unit))
+}
+
+func estimateToData(estimate *entity.Estimate) *presenter.Estimate {
+	if estimate == nil {
+		return nil
+	}
+	return &presenter.Estimate{Value: estimate.Value, Unit: presenter.EstimateUnit(estimate.Unit)}
+}
+
+func clearFieldsToEntity(fields *[]presenter.ClearField) ([]entity.ClearField, error) {
+	if fields == nil {
+		return nil, nil
+	}
+	clear := make([]entity.ClearField, len(*fields))
+	for i, field := range *fields {
+		clearField, err := entity.NewClearField(string(field))
+		if err != nil {
+			return nil, err
+		}
+		clear[i] = *clearField
+	}
+	return clear, nil
+}
+
+func taskIDToEntity(id *int) *entity.TaskID {
+	if id == nil {
+		return nil
+	}
+	taskID := entity.TaskID(*id)
+	return &taskID
+}
+
+func projectIDToEntity(id *int) *entity.ProjectID {
+	if id == nil {
+		return nil
+	}
+	projectID := entity.ProjectID(*id)
+	return &projectID
+}
+
+func projectIDToData(id *entity.ProjectID) *int {
+	if id == nil {
+		return nil
+	}
+	projectID := int(*id)
+	return &projectID
+}
+
+func priorityToEntity(p *presenter.Priority) (entity.Priority, error) {
+	if p == nil {
+		return "", nil
+	}
+	priority, err := entity.NewPriority(string(*p))
+	if err != nil {
+		return "", err
+	}
+	return *priority, nil
+}
+
 func taskToData(task *entity.Task) presenter.Task {
 	return presenter.Task{
-		Kind: "task",
-		Id:   int(task.ID),
-		Name: task.Name,
+		Kind:            "task",
+		Id:              int(task.ID),
+		Name:            task.Name,
+		Description:     stringToOptional(task.Description),
+		DescriptionHtml: descriptionToHTML(task.Description),
+		Priority:        presenter.Priority(task.Priority),
 		Status: presenter.Status{
 			Id:   (*int)(&task.Status.ID),
 			Name: presenter.StatusName(task.Status.Name),
 		},
-		Deadline: timeToDeadline(task.Deadline),
+		Tags:                 tagsToData(task.Tags),
+		Checklist:            checklistItemsToData(task.ChecklistItems),
+		BlockedBy:            blockedByToData(task),
+		CompletionPercentage: task.CompletionPercentage(),
+		ProjectId:            projectIDToData(task.ProjectID),
+		Rank:                 string(task.Rank),
+		CommentCount:         task.CommentCount,
+		TrackedSeconds:       task.TrackedSeconds,
+		DeletedAt:            deletedAtToData(task.DeletedAt),
+		Recurrence:           recurrenceToData(task.Recurrence),
+		Deadline:             timeToDeadline(task.Deadline),
+		DueTime:              stringToOptional(task.DueTime()),
+		TimeZone:             stringToOptional(task.TimeZone),
+		DueAt:                timeToUTC(task.DueAt),
+		Estimate:             estimateToData(task.Estimate),
+		WorkStartedAt:        task.WorkStartedAt,
+		CompletedAt:          task.CompletedAt,
+		StartAt:              timeToUTC(task.StartAt),
+		SnoozedUntil:         timeToUTC(task.SnoozedUntil),
+		Highlight:            highlightToData(task),
+	}
+}
+
+// highlightToData は全文検索の結果でだけ強調表示を返す
+func highlightToData(task *entity.Task) *presenter.TaskHighlight {
+	if task.HighlightedName == "" {
+		return nil
+	}
+	return &presenter.TaskHighlight{
+		Name:        task.HighlightedName,
+		Description: task.HighlightedDescription,
 	}
 }
 
@@ -65,7 +229,8 @@ func taskToResponse(task *entity.Task) presenter.TaskResponse {
 	}
 }
 
-func tasksToResponse(tasks *[]entity.Task) presenter.TasksResponse {
+// taskListToResponse はページングしないタスク一覧をレスポンスにする
+func taskListToResponse(tasks *[]entity.Task) presenter.TasksResponse {
 	data := make([]presenter.Task, len(*tasks))
 	for i, task := range *tasks {
 		data[i] = taskToData(&task)
@@ -76,6 +241,172 @@ func tasksToResponse(tasks *[]entity.Task) presenter.TasksResponse {
 	}
 }
 
+func tasksToResponse(page *entity.TaskPage) presenter.TasksResponse {
+	data := make([]presenter.Task, len(page.Tasks))
+	for i, task := range page.Tasks {
+		data[i] = taskToData(&task)
+	}
+	return presenter.TasksResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+		NextCursor: page.NextCursor,
+	}
+}
+
+// taskFilterToQuery は一覧の絞り込み条件を検証してエンティティにする。GET /tasks と保存したビューで共通
+func taskFilterToQuery(filter presenter.TaskFilter) (*entity.TaskQuery, error) {
+	query := entity.NewTaskQuery()
+	if filter.Statuses != nil {
+		for _, name := range *filter.Statuses {
+			statusName, err := entity.NewStatusName(string(name))
+			if err != nil {
+				return nil, err
+			}
+			query.Statuses = append(query.Statuses, *statusName)
+		}
+	}
+	if filter.Priorities != nil {
+		for _, value := range *filter.Priorities {
+			priority, err := entity.NewPriority(string(value))
+			if err != nil {
+				return nil, err
+			}
+			query.Priorities = append(query.Priorities, *priority)
+		}
+	}
+	if filter.TagIds != nil {
+		for _, tagID := range *filter.TagIds {
+			query.TagIDs = append(query.TagIDs, entity.TagID(tagID))
+		}
+	}
+	query.ProjectID = projectIDToEntity(filter.ProjectId)
+	query.Inbox = filter.Inbox != nil && *filter.Inbox
+	query.DeadlineFrom = deadlineToTime(filter.DeadlineFrom)
+	query.DeadlineTo = deadlineToTime(filter.DeadlineTo)
+	query.DueWithinDays = filter.DueWithinDays
+	query.IncludeSnoozed = filter.IncludeSnoozed != nil && *filter.IncludeSnoozed
+	if filter.Text != nil {
+		query.Text = *filter.Text
+		// 全文検索では並び替えを指定しなければ関連度順にする
+		query.SortBy = entity.SortByRelevance
+	}
+	if filter.Sort != nil {
+		if err := query.SortBy.Set(string(*filter.Sort)); err != nil {
+			return nil, err
+		}
+	}
+	if filter.Order != nil {
+		if err := query.Order.Set(string(*filter.Order)); err != nil {
+			return nil, err
+		}
+	} else if query.SortBy == entity.SortByRelevance {
+		query.Order = entity.Desc
+	}
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+func queryToTaskFilter(query *entity.TaskQuery) presenter.TaskFilter {
+	filter := presenter.TaskFilter{
+		ProjectId:     projectIDToData(query.ProjectID),
+		DeadlineFrom:  timeToDeadline(query.DeadlineFrom),
+		DeadlineTo:    timeToDeadline(query.DeadlineTo),
+		DueWithinDays: query.DueWithinDays,
+	}
+	if len(query.Statuses) > 0 {
+		statuses := make([]presenter.StatusName, len(query.Statuses))
+		for i, status := range query.Statuses {
+			statuses[i] = presenter.StatusName(status)
+		}
+		filter.Statuses = &statuses
+	}
+	if len(query.Priorities) > 0 {
+		priorities := make([]presenter.Priority, len(query.Priorities))
+		for i, priority := range query.Priorities {
+			priorities[i] = presenter.Priority(priority)
+		}
+		filter.Priorities = &priorities
+	}
+	if len(query.TagIDs) > 0 {
+		tagIDs := make([]int, len(query.TagIDs))
+		for i, tagID := range query.TagIDs {
+			tagIDs[i] = int(tagID)
+		}
+		filter.TagIds = &tagIDs
+	}
+	if query.Inbox {
+		filter.Inbox = &query.Inbox
+	}
+	if query.IncludeSnoozed {
+		filter.IncludeSnoozed = &query.IncludeSnoozed
+	}
+	if query.Text != "" {
+		filter.Text = &query.Text
+	}
+	if query.SortBy != "" {
+		sort := presenter.TaskSortField(query.SortBy)
+		filter.Sort = &sort
+	}
+	if query.Order != "" {
+		order := presenter.SortOrder(query.Order)
+		filter.Order = &order
+	}
+	return filter
+}
+
+func paramsToTaskQuery(params presenter.GetAllTasksParams) (*entity.TaskQuery, error) {
+	query, err := taskFilterToQuery(presenter.TaskFilter{
+		Statuses:       params.Status,
+		Priorities:     params.Priority,
+		TagIds:         params.TagId,
+		ProjectId:      params.ProjectId,
+		Inbox:          params.Inbox,
+		IncludeSnoozed: params.IncludeSnoozed,
+		DeadlineFrom:   params.DeadlineFrom,
+		DeadlineTo:     params.DeadlineTo,
+		Text:           params.Text,
+		Sort:           params.Sort,
+		Order:          params.Order,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	return query, nil
+}
+
+// searchParamsToTaskQuery は検索クエリを解析する。検索ではスヌーズ中のタスクも対象にする
+func searchParamsToTaskQuery(params presenter.SearchTasksParams) (*entity.TaskQuery, error) {
+	search, err := entity.ParseTaskSearch(params.Q)
+	if err != nil {
+		return nil, err
+	}
+	includeSnoozed := true
+	query, err := taskFilterToQuery(presenter.TaskFilter{
+		IncludeSnoozed: &includeSnoozed,
+		Sort:           params.Sort,
+		Order:          params.Order,
+	})
+	if err != nil {
+		return nil, err
+	}
+	query.Search = search
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+	return query, nil
+}
+
 func getUserIDFromContext(c *gin.Context) (entity.UserID, error) {
 	userID, exists := c.Get("user_id")
 	if !exists {
@@ -107,6 +438,27 @@ func (th *taskHandler) CreateTask(c *gin.Context) {
 		return
 	}
 
+	priority, err := priorityToEntity(requestBody.Priority)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	recurrence, err := recurrenceToEntity(requestBody.Recurrence)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	estimate, err := estimateToEntity(requestBody.Estimate)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
 	userID, err := getUserIDFromContext(c)
 	if err != nil {
 		logger.Warn(err.Error())
@@ -115,14 +467,30 @@ func (th *taskHandler) CreateTask(c *gin.Context) {
 	}
 
 	task := &entity.Task{
-		Name:     requestBody.Name,
-		Status:   *status,
-		UserID:   userID,
-		Deadline: deadlineToTime(requestBody.Deadline),
+		Name:        requestBody.Name,
+		Description: optionalString(requestBody.Description),
+		Priority:    priority,
+		Tags:        tagIDsToTags(requestBody.TagIds),
+		ProjectID:   projectIDToEntity(requestBody.ProjectId),
+		Recurrence:  recurrence,
+		Status:      *status,
+		UserID:      userID,
+		Estimate:    estimate,
+		StartAt:     timeToUTC(requestBody.StartAt),
+	}
+	if err := task.SetDeadline(deadlineToTime(requestBody.Deadline), optionalString(requestBody.DueTime), optionalString(requestBody.TimeZone)); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
 	}
 
 	createdTask, err := th.tu.Create(task)
 	if err != nil {
+		if errors.Is(err, entity.ErrTagNotFound) || errors.Is(err, entity.ErrProjectNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
 		logger.Error(err.Error())
 		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
 		return
@@ -149,7 +517,7 @@ func (th *taskHandler) GetTaskById(c *gin.Context, id int) {
 	c.JSON(http.StatusOK, taskToResponse(task))
 }
 
-func (th *taskHandler) GetAllTasks(c *gin.Context) {
+func (th *taskHandler) GetAllTasks(c *gin.Context, params presenter.GetAllTasksParams) {
 	userID, err := getUserIDFromContext(c)
 	if err != nil {
 		logger.Warn(err.Error())
@@ -157,13 +525,56 @@ func (th *taskHandler) GetAllTasks(c *gin.Context) {
 		return
 	}
 
-	tasks, err := th.tu.GetAll(userID)
+	query, err := paramsToTaskQuery(params)
 	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	page, err := th.tu.GetAll(userID, query)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidCursor) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
 		logger.Error(err.Error())
 		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
 		return
 	}
-	c.JSON(http.StatusOK, tasksToResponse(tasks))
+
+	c.JSON(http.StatusOK, tasksToResponse(page))
+}
+
+func (th *taskHandler) SearchTasks(c *gin.Context, params presenter.SearchTasksParams) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	query, err := searchParamsToTaskQuery(params)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	page, err := th.tu.GetAll(userID, query)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidCursor) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, tasksToResponse(page))
 }
 
 func (th *taskHandler) UpdateTaskById(c *gin.Context, id int) {
@@ -181,6 +592,38 @@ func (th *taskHandler) UpdateTaskById(c *gin.Context, id int) {
 		return
 	}
 
+	priority, err := priorityToEntity(requestBody.Priority)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	recurrence, err := recurrenceToEntity(requestBody.Recurrence)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	estimate, err := estimateToEntity(requestBody.Estimate)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	clear, err := clearFieldsToEntity(requestBody.Clear)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+	// 空の説明は省略と区別できないため、明示的に空文字を指定したら説明を消す
+	if requestBody.Description != nil && *requestBody.Description == "" {
+		clear = append(clear, entity.ClearDescription)
+	}
+
 	userID, err := getUserIDFromContext(c)
 	if err != nil {
 		logger.Warn(err.Error())
@@ -191,15 +634,41 @@ func (th *taskHandler) UpdateTaskById(c *gin.Context, id int) {
 	taskID := entity.TaskID(id)
 
 	task := &entity.Task{
-		ID:       taskID,
-		Name:     requestBody.Name,
-		Status:   *status,
-		UserID:   userID,
-		Deadline: deadlineToTime(requestBody.Deadline),
+		ID:          taskID,
+		Name:        requestBody.Name,
+		Description: optionalString(requestBody.Description),
+		Priority:    priority,
+		Tags:        tagIDsToTags(requestBody.TagIds),
+		ProjectID:   projectIDToEntity(requestBody.ProjectId),
+		Recurrence:  recurrence,
+		Status:      *status,
+		UserID:      userID,
+		Estimate:    estimate,
+		StartAt:     timeToUTC(requestBody.StartAt),
+	}
+	if err := task.SetDeadline(deadlineToTime(requestBody.Deadline), optionalString(requestBody.DueTime), optionalString(requestBody.TimeZone)); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+	if err := task.CheckClear(clear); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
 	}
 
-	updatedTask, err := th.tu.Save(task)
+	updatedTask, err := th.tu.Save(task, clear)
 	if err != nil {
+		if errors.Is(err, entity.ErrTagNotFound) || errors.Is(err, entity.ErrProjectNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrTaskBlocked) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusConflict, err.Error()))
+			return
+		}
 		logger.Error(err.Error())
 		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
 		return
@@ -207,6 +676,105 @@ func (th *taskHandler) UpdateTaskById(c *gin.Context, id int) {
 	c.JSON(http.StatusOK, taskToResponse(updatedTask))
 }
 
+func (th *taskHandler) MoveTask(c *gin.Context, id int) {
+	var requestBody presenter.MoveTaskRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	status, err := entity.NewStatusName(string(requestBody.Status))
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	movedTask, err := th.tu.Move(entity.TaskID(id), userID, *status, taskIDToEntity(requestBody.PrevId), taskIDToEntity(requestBody.NextId))
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidMove) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrTaskBlocked) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusConflict, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	c.JSON(http.StatusOK, taskToResponse(movedTask))
+}
+
+func (th *taskHandler) MoveTaskToProject(c *gin.Context, id int) {
+	var requestBody presenter.MoveTaskToProjectRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	movedTask, err := th.tu.MoveToProject(entity.TaskID(id), userID, projectIDToEntity(requestBody.ProjectId))
+	if err != nil {
+		if errors.Is(err, entity.ErrProjectNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	c.JSON(http.StatusOK, taskToResponse(movedTask))
+}
+
+func (th *taskHandler) SnoozeTask(c *gin.Context, id int) {
+	var requestBody presenter.SnoozeTaskRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	snoozedTask, err := th.tu.Snooze(entity.TaskID(id), userID, requestBody.Until)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidSnooze) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	c.JSON(http.StatusOK, taskToResponse(snoozedTask))
+}
+
 func (th *taskHandler) DeleteTaskById(c *gin.Context, id int) {
 	userID, err := getUserIDFromContext(c)
 	if err != nil {
@@ -222,3 +790,65 @@ func (th *taskHandler) DeleteTaskById(c *gin.Context, id int) {
 	}
 	c.Status(http.StatusNoContent)
 }
+
+func fieldChangesToData(changes entity.FieldChanges) []presenter.FieldChange {
+	data := make([]presenter.FieldChange, len(changes))
+	for i, change := range changes {
+		data[i] = presenter.FieldChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+	return data
+}
+
+func taskEventsToResponse(page *entity.TaskEventPage) presenter.TaskEventsResponse {
+	data := make([]presenter.TaskEvent, len(page.Events))
+	for i, event := range page.Events {
+		data[i] = presenter.TaskEvent{
+			Kind:      "taskEvent",
+			Id:        int(event.ID),
+			TaskId:    int(event.TaskID),
+			UserId:    int(event.UserID),
+			Type:      presenter.TaskEventType(event.Type),
+			Changes:   fieldChangesToData(event.Changes),
+			CreatedAt: event.CreatedAt,
+		}
+	}
+	return presenter.TaskEventsResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func (th *taskHandler) GetTaskHistory(c *gin.Context, id int, params presenter.GetTaskHistoryParams) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	query := entity.NewTaskEventQuery()
+	if params.Cursor != nil {
+		query.Cursor = *params.Cursor
+	}
+	if params.Limit != nil {
+		query.Limit = *params.Limit
+	}
+
+	page, err := th.tu.GetHistory(entity.TaskID(id), userID, query)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidCursor) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+	c.JSON(http.StatusOK, taskEventsToResponse(page))
+}
@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ITimeEntryHandler interface {
+	GetTaskTimeEntries(c *gin.Context, id int)
+	CreateTimeEntry(c *gin.Context, id int)
+	UpdateTimeEntry(c *gin.Context, id int, entryId int)
+	DeleteTimeEntry(c *gin.Context, id int, entryId int)
+	StartTimer(c *gin.Context, id int)
+	GetTimer(c *gin.Context)
+	StopTimer(c *gin.Context)
+}
+
+type timeEntryHandler struct {
+	teu usecase.ITimeEntryUsecase
+}
+
+func NewTimeEntryHandler(teu usecase.ITimeEntryUsecase) ITimeEntryHandler {
+	return &timeEntryHandler{teu: teu}
+}
+
+func timeEntryToData(entry *entity.TimeEntry) presenter.TimeEntry {
+	return presenter.TimeEntry{
+		Kind:            "timeEntry",
+		Id:              int(entry.ID),
+		TaskId:          int(entry.TaskID),
+		StartedAt:       entry.StartedAt,
+		EndedAt:         entry.EndedAt,
+		DurationSeconds: entry.DurationSeconds,
+		Note:            entry.Note,
+	}
+}
+
+func timeEntryToResponse(entry *entity.TimeEntry) presenter.TimeEntryResponse {
+	return presenter.TimeEntryResponse{
+		ApiVersion: api.Version,
+		Data:       timeEntryToData(entry),
+	}
+}
+
+func timeEntriesToResponse(entries *[]entity.TimeEntry) presenter.TimeEntriesResponse {
+	data := make([]presenter.TimeEntry, len(*entries))
+	for i, entry := range *entries {
+		data[i] = timeEntryToData(&entry)
+	}
+	return presenter.TimeEntriesResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+	}
+}
+
+func noteToString(note *string) string {
+	if note == nil {
+		return ""
+	}
+	return *note
+}
+
+func timeToEntity(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func (teh *timeEntryHandler) GetTaskTimeEntries(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	entries, err := teh.teu.GetAll(entity.TaskID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, timeEntriesToResponse(entries))
+}
+
+func (teh *timeEntryHandler) CreateTimeEntry(c *gin.Context, id int) {
+	var requestBody presenter.CreateTimeEntryRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	entry := &entity.TimeEntry{
+		TaskID:    entity.TaskID(id),
+		UserID:    userID,
+		StartedAt: requestBody.StartedAt,
+		EndedAt:   &requestBody.EndedAt,
+		Note:      noteToString(requestBody.Note),
+	}
+
+	createdEntry, err := teh.teu.Create(entry)
+	if err != nil {
+		if errors.Is(err, entity.ErrTaskNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrInvalidTimeRange) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, timeEntryToResponse(createdEntry))
+}
+
+func (teh *timeEntryHandler) UpdateTimeEntry(c *gin.Context, id int, entryId int) {
+	var requestBody presenter.UpdateTimeEntryRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	entry := &entity.TimeEntry{
+		ID:        entity.TimeEntryID(entryId),
+		TaskID:    entity.TaskID(id),
+		UserID:    userID,
+		StartedAt: timeToEntity(requestBody.StartedAt),
+		EndedAt:   requestBody.EndedAt,
+		Note:      noteToString(requestBody.Note),
+	}
+
+	updatedEntry, err := teh.teu.Save(entry)
+	if err != nil {
+		if errors.Is(err, entity.ErrTimeEntryNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrInvalidTimeRange) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, timeEntryToResponse(updatedEntry))
+}
+
+func (teh *timeEntryHandler) DeleteTimeEntry(c *gin.Context, id int, entryId int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := teh.teu.Delete(entity.TimeEntryID(entryId), entity.TaskID(id), userID); err != nil {
+		if errors.Is(err, entity.ErrTimeEntryNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (teh *timeEntryHandler) StartTimer(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	entry, err := teh.teu.Start(entity.TaskID(id), userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTaskNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrTimerAlreadyRunning) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusConflict, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, timeEntryToResponse(entry))
+}
+
+func (teh *timeEntryHandler) GetTimer(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	response := presenter.TimerResponse{ApiVersion: api.Version}
+	entry, err := teh.teu.GetRunning(userID)
+	if err != nil {
+		if !errors.Is(err, entity.ErrTimerNotRunning) {
+			logger.Error(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+			return
+		}
+	} else {
+		data := timeEntryToData(entry)
+		response.Data = &data
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (teh *timeEntryHandler) StopTimer(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	entry, err := teh.teu.Stop(userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTimerNotRunning) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusConflict, err.Error()))
+			return
+		}
+		if errors.Is(err, entity.ErrInvalidTimeRange) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, timeEntryToResponse(entry))
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ITrashHandler interface {
+	GetTrash(c *gin.Context)
+	RestoreTask(c *gin.Context, id int)
+	DeleteTrashedTask(c *gin.Context, id int)
+}
+
+type trashHandler struct {
+	tu usecase.ITaskUsecase
+}
+
+func NewTrashHandler(tu usecase.ITaskUsecase) ITrashHandler {
+	return &trashHandler{tu: tu}
+}
+
+func deletedAtToData(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
+
+func (trh *trashHandler) GetTrash(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	tasks, err := trh.tu.GetTrash(userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, taskListToResponse(tasks))
+}
+
+func (trh *trashHandler) RestoreTask(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	task, err := trh.tu.Restore(entity.TaskID(id), userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, taskToResponse(task))
+}
+
+func (trh *trashHandler) DeleteTrashedTask(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := trh.tu.DeletePermanently(entity.TaskID(id), userID); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
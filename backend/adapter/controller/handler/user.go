@@ -7,7 +7,9 @@ import (
 	"backend/pkg/cookie"
 	"backend/pkg/logger"
 	"backend/usecase"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,6 +18,7 @@ type IUserHandler interface {
 	PostSignUp(c *gin.Context)
 	PostLogin(c *gin.Context)
 	PostLogout(c *gin.Context)
+	PostTokenRefresh(c *gin.Context)
 }
 
 type userHandler struct {
@@ -26,6 +29,59 @@ func NewUserHandler(uu usecase.IUserUsecase) IUserHandler {
 	return &userHandler{uu: uu}
 }
 
+// リフレッシュトークンはトークンの更新でしか使わないので、送るパスを絞る
+const refreshTokenCookiePath = "/api/v1/token"
+
+func setAuthCookies(c *gin.Context, tokens *entity.AuthTokens) {
+	sameSite, secure, domain := cookie.GetCookieConfig()
+
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     "token",
+		Value:    tokens.AccessToken,
+		MaxAge:   int(time.Until(tokens.AccessExpiresAt).Seconds()),
+		Path:     "/",
+		Domain:   domain,
+		Secure:   secure,
+		HttpOnly: true,
+		SameSite: sameSite,
+	})
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     "refresh_token",
+		Value:    tokens.RefreshToken,
+		MaxAge:   int(time.Until(tokens.RefreshExpiresAt).Seconds()),
+		Path:     refreshTokenCookiePath,
+		Domain:   domain,
+		Secure:   secure,
+		HttpOnly: true,
+		SameSite: sameSite,
+	})
+}
+
+func clearAuthCookies(c *gin.Context) {
+	sameSite, secure, domain := cookie.GetCookieConfig()
+
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     "token",
+		Value:    "",
+		MaxAge:   -1,
+		Path:     "/",
+		Domain:   domain,
+		Secure:   secure,
+		HttpOnly: true,
+		SameSite: sameSite,
+	})
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     "refresh_token",
+		Value:    "",
+		MaxAge:   -1,
+		Path:     refreshTokenCookiePath,
+		Domain:   domain,
+		Secure:   secure,
+		HttpOnly: true,
+		SameSite: sameSite,
+	})
+}
+
 func (uh *userHandler) PostSignUp(c *gin.Context) {
 	var requestBody presenter.SignUpRequestBody
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
@@ -37,6 +93,14 @@ func (uh *userHandler) PostSignUp(c *gin.Context) {
 	user := &entity.User{
 		Email:    requestBody.User.Email,
 		Password: *requestBody.User.Password,
+		TimeZone: optionalString(requestBody.User.TimeZone),
+	}
+	if user.TimeZone != "" {
+		if _, err := entity.LoadTimeZone(user.TimeZone); err != nil {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
 	}
 
 	// 平文パスワードを保存（Login用）
@@ -44,6 +108,11 @@ func (uh *userHandler) PostSignUp(c *gin.Context) {
 
 	createdUser, err := uh.uu.SignUp(user)
 	if err != nil {
+		if errors.Is(err, entity.ErrInvalidEmail) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
 		logger.Error(err.Error())
 		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
 		return
@@ -55,34 +124,25 @@ func (uh *userHandler) PostSignUp(c *gin.Context) {
 		Email:    createdUser.Email,
 		Password: plainPassword,
 	}
-	tokenString, err := uh.uu.Login(loginUser)
+	tokens, err := uh.uu.Login(loginUser, c.Request.UserAgent())
 	if err != nil {
 		logger.Error((err.Error()))
 		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
 		return
 	}
 
-	sameSite, secure, domain := cookie.GetCookieConfig()
-
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "token",
-		Value:    tokenString,
-		MaxAge:   24 * 60 * 60,
-		Path:     "/",
-		Domain:   domain,
-		Secure:   secure,
-		HttpOnly: true,
-		SameSite: sameSite,
-	})
+	setAuthCookies(c, tokens)
 
 	userID := int(createdUser.ID)
 
 	c.JSON(http.StatusCreated, presenter.SignUpResponse{
 		ApiVersion: api.Version,
 		Data: presenter.User{
-			Kind:  "user",
-			Id:    &userID,
-			Email: createdUser.Email,
+			Kind:            "user",
+			Id:              &userID,
+			Email:           createdUser.Email,
+			TimeZone:        stringToOptional(createdUser.TimeZone),
+			EmailVerifiedAt: createdUser.EmailVerifiedAt,
 		},
 	})
 }
@@ -100,40 +160,51 @@ func (uh *userHandler) PostLogin(c *gin.Context) {
 		Password: *requestBody.User.Password,
 	}
 
-	tokenString, err := uh.uu.Login(user)
+	tokens, err := uh.uu.Login(user, c.Request.UserAgent())
 	if err != nil {
 		logger.Error((err.Error()))
 		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
 		return
 	}
 
-	sameSite, secure, domain := cookie.GetCookieConfig()
-
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "token",
-		Value:    tokenString,
-		MaxAge:   24 * 60 * 60,
-		Path:     "/",
-		Domain:   domain,
-		Secure:   secure,
-		HttpOnly: true,
-		SameSite: sameSite,
-	})
+	setAuthCookies(c, tokens)
 	c.Status(http.StatusOK)
 }
 
 func (uh *userHandler) PostLogout(c *gin.Context) {
-	sameSite, secure, domain := cookie.GetCookieConfig()
+	if tokenString, err := c.Cookie("token"); err == nil {
+		if err := uh.uu.Logout(tokenString); err != nil {
+			logger.Error(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+			return
+		}
+	}
 
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "token",
-		Value:    "",
-		MaxAge:   -1,
-		Path:     "/",
-		Domain:   domain,
-		Secure:   secure,
-		HttpOnly: true,
-		SameSite: sameSite,
-	})
+	clearAuthCookies(c)
+	c.Status(http.StatusOK)
+}
+
+func (uh *userHandler) PostTokenRefresh(c *gin.Context) {
+	refreshToken, err := c.Cookie("refresh_token")
+	if err != nil {
+		logger.Warn("Refresh token cookie not found: " + err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication required"))
+		return
+	}
+
+	tokens, err := uh.uu.Refresh(refreshToken)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidRefreshToken) || errors.Is(err, entity.ErrRefreshTokenReused) {
+			logger.Warn(err.Error())
+			clearAuthCookies(c)
+			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	setAuthCookies(c, tokens)
 	c.Status(http.StatusOK)
 }
@@ -25,12 +25,25 @@ func (m *MockUserUseCase) SignUp(user *entity.User) (*entity.User, error) {
 	return args.Get(0).(*entity.User), args.Error(1)
 }
 
-func (m *MockUserUseCase) Login(user *entity.User) (string, error) {
-	args := m.Called(user)
+func (m *MockUserUseCase) Login(user *entity.User, device string) (*entity.AuthTokens, error) {
+	args := m.Called(user, device)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AuthTokens), args.Error(1)
+}
+
+func (m *MockUserUseCase) Refresh(refreshToken string) (*entity.AuthTokens, error) {
+	args := m.Called(refreshToken)
 	if args.Get(0) == nil {
-		return "", args.Error(1)
+		return nil, args.Error(1)
 	}
-	return args.String(0), args.Error(1)
+	return args.Get(0).(*entity.AuthTokens), args.Error(1)
+}
+
+func (m *MockUserUseCase) Logout(accessToken string) error {
+	args := m.Called(accessToken)
+	return args.Error(0)
 }
 
 func (m *MockUserUseCase) Save(user *entity.User) (*entity.User, error) {
@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"backend/adapter/controller/presenter"
+	"backend/api"
+	"backend/entity"
+	"backend/pkg/logger"
+	"backend/usecase"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IViewHandler interface {
+	GetTodayView(c *gin.Context, params presenter.GetTodayViewParams)
+	GetUpcomingView(c *gin.Context, params presenter.GetUpcomingViewParams)
+	GetOverdueView(c *gin.Context, params presenter.GetOverdueViewParams)
+	GetSomedayView(c *gin.Context, params presenter.GetSomedayViewParams)
+	CreateSavedView(c *gin.Context)
+	GetSavedViewById(c *gin.Context, id int)
+	GetSavedViews(c *gin.Context)
+	UpdateSavedViewById(c *gin.Context, id int)
+	DeleteSavedViewById(c *gin.Context, id int)
+	GetSavedViewTasks(c *gin.Context, id int, params presenter.GetSavedViewTasksParams)
+}
+
+type viewHandler struct {
+	vu usecase.IViewUsecase
+}
+
+func NewViewHandler(vu usecase.IViewUsecase) IViewHandler {
+	return &viewHandler{vu: vu}
+}
+
+func savedViewToData(view *entity.SavedView) presenter.SavedView {
+	return presenter.SavedView{
+		Kind:      "savedView",
+		Id:        int(view.ID),
+		Name:      view.Name,
+		Filter:    queryToTaskFilter(view.Filter),
+		CreatedAt: view.CreatedAt,
+	}
+}
+
+func savedViewToResponse(view *entity.SavedView) presenter.SavedViewResponse {
+	return presenter.SavedViewResponse{
+		ApiVersion: api.Version,
+		Data:       savedViewToData(view),
+	}
+}
+
+func savedViewsToResponse(views *[]entity.SavedView) presenter.SavedViewsResponse {
+	data := make([]presenter.SavedView, len(*views))
+	for i, view := range *views {
+		data[i] = savedViewToData(&view)
+	}
+	return presenter.SavedViewsResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+	}
+}
+
+func (vh *viewHandler) GetTodayView(c *gin.Context, params presenter.GetTodayViewParams) {
+	vh.getSmartList(c, entity.SmartListToday, nil, params.TimeZone)
+}
+
+func (vh *viewHandler) GetUpcomingView(c *gin.Context, params presenter.GetUpcomingViewParams) {
+	vh.getSmartList(c, entity.SmartListUpcoming, params.Days, params.TimeZone)
+}
+
+func (vh *viewHandler) GetOverdueView(c *gin.Context, params presenter.GetOverdueViewParams) {
+	vh.getSmartList(c, entity.SmartListOverdue, nil, params.TimeZone)
+}
+
+func (vh *viewHandler) GetSomedayView(c *gin.Context, params presenter.GetSomedayViewParams) {
+	vh.getSmartList(c, entity.SmartListSomeday, nil, params.TimeZone)
+}
+
+// getSmartList はスマートリストのタスクを返す。各スマートリストのハンドラーで共通の処理
+func (vh *viewHandler) getSmartList(c *gin.Context, list entity.SmartList, days *int, timeZone *presenter.TimeZone) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	var upcomingDays int
+	if days != nil {
+		upcomingDays = *days
+	}
+
+	tasks, err := vh.vu.GetSmartList(userID, list, upcomingDays, optionalString(timeZone))
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidTimeZone) || errors.Is(err, entity.ErrInvalidUpcomingDays) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, taskListToResponse(tasks))
+}
+
+func (vh *viewHandler) CreateSavedView(c *gin.Context) {
+	var requestBody presenter.CreateSavedViewRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	filter, err := taskFilterToQuery(requestBody.Filter)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	view := &entity.SavedView{
+		Name:   requestBody.Name,
+		Filter: filter,
+		UserID: userID,
+	}
+
+	createdView, err := vh.vu.Create(view)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, savedViewToResponse(createdView))
+}
+
+func (vh *viewHandler) GetSavedViewById(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	view, err := vh.vu.Get(entity.SavedViewID(id), userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrSavedViewNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, savedViewToResponse(view))
+}
+
+func (vh *viewHandler) GetSavedViews(c *gin.Context) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	views, err := vh.vu.GetAll(userID)
+	if err != nil {
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, savedViewsToResponse(views))
+}
+
+func (vh *viewHandler) UpdateSavedViewById(c *gin.Context, id int) {
+	var requestBody presenter.UpdateSavedViewRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	view := &entity.SavedView{
+		ID:     entity.SavedViewID(id),
+		Name:   optionalString(requestBody.Name),
+		UserID: userID,
+	}
+	if requestBody.Filter != nil {
+		filter, err := taskFilterToQuery(*requestBody.Filter)
+		if err != nil {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		view.Filter = filter
+	}
+
+	updatedView, err := vh.vu.Save(view)
+	if err != nil {
+		if errors.Is(err, entity.ErrSavedViewNotFound) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, savedViewToResponse(updatedView))
+}
+
+func (vh *viewHandler) DeleteSavedViewById(c *gin.Context, id int) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	if err := vh.vu.Delete(entity.SavedViewID(id), userID); err != nil {
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (vh *viewHandler) GetSavedViewTasks(c *gin.Context, id int, params presenter.GetSavedViewTasksParams) {
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		logger.Warn(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, err.Error()))
+		return
+	}
+
+	var limit int
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	page, err := vh.vu.GetTasks(entity.SavedViewID(id), userID, optionalString(params.Cursor), limit)
+	if err != nil {
+		if errors.Is(err, entity.ErrSavedViewNotFound) || errors.Is(err, entity.ErrInvalidCursor) {
+			logger.Warn(err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, err.Error()))
+			return
+		}
+		logger.Error(err.Error())
+		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, tasksToResponse(page))
+}
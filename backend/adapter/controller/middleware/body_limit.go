@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"net/http"
+
+	"backend/adapter/controller/presenter"
+	"backend/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BodyLimitMiddleware はリクエストボディを limit バイトまでに制限する。
+// OAPI バリデータがボディを読み込む前に適用する
+func BodyLimitMiddleware(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > limit {
+			logger.Warn("Request body is too large", "contentLength", c.Request.ContentLength, "limit", limit)
+			c.JSON(presenter.NewErrorResponse(http.StatusRequestEntityTooLarge, "request body is too large"))
+			c.Abort()
+			return
+		}
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
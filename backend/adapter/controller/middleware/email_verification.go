@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"backend/adapter/controller/presenter"
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EmailVerificationMiddleware はメールアドレスを確認していないユーザーのリクエストを policy に従って制限する。
+// JwtAuthMiddleware の後に置く
+func EmailVerificationMiddleware(policy *entity.EmailVerificationPolicy, ur gateway.IUserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 未確認でも許されるリクエストならユーザーを読み込まない
+		if policy.Allows(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		userID, ok := c.Get("user_id")
+		userIDFloat, isFloat := userID.(float64)
+		if !ok || !isFloat {
+			logger.Warn("user_id not found in context")
+			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication failed"))
+			c.Abort()
+			return
+		}
+
+		user, err := ur.Get(entity.UserID(userIDFloat))
+		if err != nil {
+			logger.Warn("Failed to load user for email verification: " + err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication failed"))
+			c.Abort()
+			return
+		}
+
+		if !user.IsEmailVerified() {
+			logger.Warn(fmt.Sprintf("Unverified user %d is not allowed to %s %s", user.ID, c.Request.Method, c.Request.URL.Path))
+			c.JSON(presenter.NewErrorResponse(http.StatusForbidden, entity.ErrEmailNotVerified.Error()))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
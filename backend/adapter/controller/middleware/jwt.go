@@ -4,15 +4,20 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	"backend/adapter/controller/presenter"
+	"backend/adapter/gateway"
+	"backend/entity"
 	"backend/pkg/logger"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v4"
 )
 
-func JwtAuthMiddleware() gin.HandlerFunc {
+// JwtAuthMiddleware は JWT を検証する。ログアウトなどで revocations に記録されたトークンと、
+// 失効したセッションのトークンは受け付けない
+func JwtAuthMiddleware(revocations gateway.ITokenRevocationStore, sessions gateway.ISessionRepository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenString, err := c.Cookie("token")
 		if err != nil {
@@ -55,16 +60,61 @@ func JwtAuthMiddleware() gin.HandlerFunc {
 
 		logger.Info("Claims: " + fmt.Sprintf("%+v", claims))
 
-		userID, exists := claims["user_id"]
-		if !exists {
+		userID, ok := claims["user_id"].(float64)
+		if !ok {
 			logger.Warn("user_id not found in claims")
 			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication failed"))
 			c.Abort()
 			return
 		}
 
+		jti, ok := claims["jti"].(string)
+		if !ok {
+			logger.Warn("jti not found in claims")
+			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication failed"))
+			c.Abort()
+			return
+		}
+
+		revoked, err := revocations.IsRevoked(jti)
+		if err != nil {
+			logger.Error("Failed to check token revocation: " + err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, "authentication failed"))
+			c.Abort()
+			return
+		}
+		if revoked {
+			logger.Warn("Jwt token has been revoked")
+			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication failed"))
+			c.Abort()
+			return
+		}
+
+		// 別の端末からセッションを失効させたときは、期限内のアクセストークンも使えなくする
+		sessionID, ok := claims["sid"].(float64)
+		if !ok {
+			logger.Warn("sid not found in claims")
+			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication failed"))
+			c.Abort()
+			return
+		}
+		active, err := sessions.IsActive(entity.SessionID(sessionID), entity.UserID(userID), time.Now())
+		if err != nil {
+			logger.Error("Failed to check session: " + err.Error())
+			c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, "authentication failed"))
+			c.Abort()
+			return
+		}
+		if !active {
+			logger.Warn("Session has been revoked")
+			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "authentication failed"))
+			c.Abort()
+			return
+		}
+
 		c.Set("user", token)
 		c.Set("user_id", userID)
+		c.Set("session_id", sessionID)
 		logger.Info("user authenticated successfully with user_id: " + fmt.Sprintf("%v", userID))
 
 		c.Next()
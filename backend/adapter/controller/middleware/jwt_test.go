@@ -0,0 +1,76 @@
+package middleware_test
+
+import (
+	"backend/adapter/controller/middleware"
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type JwtAuthMiddlewareSuite struct {
+	tester.DBSQLiteSuite
+	sr     gateway.ISessionRepository
+	ur     gateway.IUserRepository
+	router *gin.Engine
+}
+
+func TestJwtAuthMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(JwtAuthMiddlewareSuite))
+}
+
+func (suite *JwtAuthMiddlewareSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.sr = gateway.NewSessionRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+
+	gin.SetMode(gin.TestMode)
+	suite.router = gin.New()
+	suite.router.GET("/", middleware.JwtAuthMiddleware(gateway.NewMemoryTokenRevocationStore(), suite.sr), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+}
+
+// request は sessionID のセッションのアクセストークンを付けてリクエストし、ステータスコードを返す
+func (suite *JwtAuthMiddlewareSuite) request(userID entity.UserID, sessionID entity.SessionID) int {
+	suite.T().Setenv("SECRET", "secret")
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"jti":     "jti",
+		"user_id": userID,
+		"sid":     sessionID,
+		"exp":     time.Now().Add(time.Minute).Unix(),
+	}).SignedString([]byte("secret"))
+	suite.Require().Nil(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: "token", Value: token})
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	return w.Code
+}
+
+func (suite *JwtAuthMiddlewareSuite) TestRevokedSession() {
+	user, err := suite.ur.Create(&entity.User{Email: "jwt@test.com"})
+	suite.Require().Nil(err)
+	now := time.Now().UTC()
+	refreshToken, _, err := entity.NewRefreshToken(0, now)
+	suite.Require().Nil(err)
+	session, err := suite.sr.Create(entity.NewSession(user.ID, "laptop", now), refreshToken)
+	suite.Require().Nil(err)
+
+	// test the token of an active session is accepted
+	suite.Assert().Equal(http.StatusNoContent, suite.request(user.ID, session.ID))
+	// test the token is bound to the user of the session
+	suite.Assert().Equal(http.StatusUnauthorized, suite.request(user.ID+1, session.ID))
+
+	// test the token is rejected once its session is revoked from another device
+	suite.Require().Nil(suite.sr.Revoke(session.ID, user.ID, now))
+	suite.Assert().Equal(http.StatusUnauthorized, suite.request(user.ID, session.ID))
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -19,6 +20,42 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ClearField.
+const (
+	ClearFieldDeadline    ClearField = "deadline"
+	ClearFieldDescription ClearField = "description"
+	ClearFieldEstimate    ClearField = "estimate"
+	ClearFieldRecurrence  ClearField = "recurrence"
+	ClearFieldStartAt     ClearField = "startAt"
+)
+
+// Defines values for EstimateUnit.
+const (
+	Minutes EstimateUnit = "minutes"
+	Points  EstimateUnit = "points"
+)
+
+// Defines values for Priority.
+const (
+	High   Priority = "high"
+	Low    Priority = "low"
+	Medium Priority = "medium"
+	None   Priority = "none"
+	Urgent Priority = "urgent"
+)
+
+// Defines values for ProjectDeleteMode.
+const (
+	Cascade ProjectDeleteMode = "cascade"
+	Inbox   ProjectDeleteMode = "inbox"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
 // Defines values for StatusName.
 const (
 	Archive    StatusName = "archive"
@@ -28,159 +65,2683 @@ const (
 	Todo       StatusName = "todo"
 )
 
+// Defines values for TaskEventType.
+const (
+	Created  TaskEventType = "created"
+	Deleted  TaskEventType = "deleted"
+	Restored TaskEventType = "restored"
+	Updated  TaskEventType = "updated"
+)
+
+// Defines values for TaskSortField.
+const (
+	TaskSortFieldCreatedAt TaskSortField = "createdAt"
+	TaskSortFieldDeadline  TaskSortField = "deadline"
+	TaskSortFieldName      TaskSortField = "name"
+	TaskSortFieldPosition  TaskSortField = "position"
+	TaskSortFieldPriority  TaskSortField = "priority"
+	TaskSortFieldRelevance TaskSortField = "relevance"
+)
+
 // ApiVersion defines model for ApiVersion.
 type ApiVersion = string
 
+// Attachment defines model for Attachment.
+type Attachment struct {
+	// ContentType MIME type detected from the content of the file
+	ContentType string    `json:"contentType"`
+	CreatedAt   time.Time `json:"createdAt"`
+	FileName    string    `json:"fileName"`
+	Id          int       `json:"id"`
+	Kind        string    `json:"kind"`
+
+	// Size Size of the file in bytes
+	Size   int64 `json:"size"`
+	TaskId int   `json:"taskId"`
+}
+
+// AttachmentResponse defines model for AttachmentResponse.
+type AttachmentResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       Attachment `json:"data"`
+}
+
+// AttachmentsResponse defines model for AttachmentsResponse.
+type AttachmentsResponse struct {
+	ApiVersion ApiVersion   `json:"apiVersion"`
+	Data       []Attachment `json:"data"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	Checked  bool   `json:"checked"`
+	Id       int    `json:"id"`
+	Kind     string `json:"kind"`
+	Position int    `json:"position"`
+	Text     string `json:"text"`
+}
+
+// ChecklistItemResponse defines model for ChecklistItemResponse.
+type ChecklistItemResponse struct {
+	ApiVersion ApiVersion    `json:"apiVersion"`
+	Data       ChecklistItem `json:"data"`
+}
+
+// ChecklistItemsResponse defines model for ChecklistItemsResponse.
+type ChecklistItemsResponse struct {
+	ApiVersion ApiVersion      `json:"apiVersion"`
+	Data       []ChecklistItem `json:"data"`
+}
+
+// ClearField A task field whose value is removed on update
+type ClearField string
+
+// Color defines model for Color.
+type Color = string
+
+// Comment defines model for Comment.
+type Comment struct {
+	// Body Comment written in Markdown
+	Body string `json:"body"`
+
+	// BodyHtml Body rendered from Markdown to sanitized HTML
+	BodyHtml  string    `json:"bodyHtml"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EditedAt When the comment was last edited. null if it has never been edited
+	EditedAt *time.Time `json:"editedAt"`
+	Id       int        `json:"id"`
+	Kind     string     `json:"kind"`
+	TaskId   int        `json:"taskId"`
+
+	// UserId Author of the comment
+	UserId int `json:"userId"`
+}
+
+// CommentResponse defines model for CommentResponse.
+type CommentResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       Comment    `json:"data"`
+}
+
+// CommentsResponse defines model for CommentsResponse.
+type CommentsResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       []Comment  `json:"data"`
+}
+
+// CreateChecklistItemRequestBody defines model for CreateChecklistItemRequestBody.
+type CreateChecklistItemRequestBody struct {
+	Kind *string `json:"kind,omitempty"`
+	Text string  `json:"text"`
+}
+
+// CreateCommentRequestBody defines model for CreateCommentRequestBody.
+type CreateCommentRequestBody struct {
+	Body string  `json:"body"`
+	Kind *string `json:"kind,omitempty"`
+}
+
+// CreateProjectRequestBody defines model for CreateProjectRequestBody.
+type CreateProjectRequestBody struct {
+	Color *Color  `json:"color,omitempty"`
+	Kind  *string `json:"kind,omitempty"`
+	Name  string  `json:"name"`
+}
+
+// CreateSavedViewRequestBody defines model for CreateSavedViewRequestBody.
+type CreateSavedViewRequestBody struct {
+	// Filter Filter and order of a task list. The fields work like the query parameters of GET /tasks
+	Filter TaskFilter `json:"filter"`
+	Kind   *string    `json:"kind,omitempty"`
+	Name   string     `json:"name"`
+}
+
+// CreateTagRequestBody defines model for CreateTagRequestBody.
+type CreateTagRequestBody struct {
+	Kind *string `json:"kind,omitempty"`
+	Name string  `json:"name"`
+}
+
+// CreateTaskDependencyRequestBody defines model for CreateTaskDependencyRequestBody.
+type CreateTaskDependencyRequestBody struct {
+	// BlockerId ID of the task that must be done first
+	BlockerId int `json:"blockerId"`
+}
+
 // CreateTaskRequestBody defines model for CreateTaskRequestBody.
 type CreateTaskRequestBody struct {
+	// Deadline Date of the deadline. Without dueTime the task is due at any time on this date
 	Deadline *Deadline `json:"deadline,omitempty"`
+
+	// Description Markdown text
+	Description *Description `json:"description,omitempty"`
+
+	// DueTime Time of day (HH:MM) in timeZone when the task is due. Requires deadline and timeZone. Updating deadline without dueTime makes it an all-day deadline
+	DueTime *DueTime `json:"dueTime,omitempty"`
+
+	// Estimate Estimated effort in minutes or story points. Omit to keep the current estimate on update
+	Estimate *Estimate `json:"estimate,omitempty"`
 	Kind     *string   `json:"kind,omitempty"`
 	Name     string    `json:"name"`
-	Status   Status    `json:"status"`
+	Priority *Priority `json:"priority,omitempty"`
+
+	// ProjectId ID of the project the task belongs to
+	ProjectId *int `json:"projectId,omitempty"`
+
+	// Recurrence RFC 5545 RRULE subset (FREQ=DAILY|WEEKLY|MONTHLY|YEARLY, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, COUNT, UNTIL). When a recurring task is moved to done, the next occurrence is created with its deadline advanced
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+
+	// StartAt The task is hidden from the task list until it can be started at this time
+	StartAt *StartAt `json:"startAt,omitempty"`
+	Status  Status   `json:"status"`
+
+	// TagIds IDs of the tags attached to the task. Omit to keep the current tags
+	TagIds *[]int `json:"tagIds,omitempty"`
+
+	// TimeZone IANA time zone name. On tasks it is the zone the deadline is given in and requires deadline
+	TimeZone *TimeZone `json:"timeZone,omitempty"`
+}
+
+// CreateTimeEntryRequestBody defines model for CreateTimeEntryRequestBody.
+type CreateTimeEntryRequestBody struct {
+	EndedAt   time.Time `json:"endedAt"`
+	Note      *string   `json:"note,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// CsrfToken defines model for CsrfToken.
+type CsrfToken = string
+
+// CsrfTokenResponse defines model for CsrfTokenResponse.
+type CsrfTokenResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       CsrfToken  `json:"data"`
+}
+
+// Deadline Date of the deadline. Without dueTime the task is due at any time on this date
+type Deadline = openapi_types.Date
+
+// Description Markdown text
+type Description = string
+
+// DueTime Time of day (HH:MM) in timeZone when the task is due. Requires deadline and timeZone. Updating deadline without dueTime makes it an all-day deadline
+type DueTime = string
+
+// Error defines model for Error.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error Error `json:"error"`
+}
+
+// Estimate Estimated effort in minutes or story points. Omit to keep the current estimate on update
+type Estimate struct {
+	Unit  EstimateUnit `json:"unit"`
+	Value int          `json:"value"`
+}
+
+// EstimateReport defines model for EstimateReport.
+type EstimateReport struct {
+	From openapi_types.Date  `json:"from"`
+	Kind string              `json:"kind"`
+	Rows []EstimateReportRow `json:"rows"`
+	To   openapi_types.Date  `json:"to"`
+}
+
+// EstimateReportResponse defines model for EstimateReportResponse.
+type EstimateReportResponse struct {
+	ApiVersion ApiVersion     `json:"apiVersion"`
+	Data       EstimateReport `json:"data"`
+}
+
+// EstimateReportRow defines model for EstimateReportRow.
+type EstimateReportRow struct {
+	// CycleTimeSeconds Sum of the cycle times of the tasks
+	CycleTimeSeconds int64 `json:"cycleTimeSeconds"`
+
+	// EstimateTotal Sum of the estimates in unit
+	EstimateTotal int `json:"estimateTotal"`
+
+	// TaskCount Number of tasks completed
+	TaskCount int `json:"taskCount"`
+
+	// TrackedSeconds Sum of the time logged on the tasks
+	TrackedSeconds int64 `json:"trackedSeconds"`
+
+	// Unit Unit of the estimates. null for tasks without an estimate
+	Unit   *EstimateUnit `json:"unit"`
+	UserId int           `json:"userId"`
+
+	// WeekStart Monday of the week the tasks were completed in
+	WeekStart openapi_types.Date `json:"weekStart"`
+}
+
+// EstimateUnit defines model for EstimateUnit.
+type EstimateUnit string
+
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	// After Value after the change. null when the field is empty
+	After interface{} `json:"after"`
+
+	// Before Value before the change. null when the field was empty
+	Before interface{} `json:"before"`
+	Field  string      `json:"field"`
+}
+
+// LoginRequestBody defines model for LoginRequestBody.
+type LoginRequestBody struct {
+	Kind *string `json:"kind,omitempty"`
+	User User    `json:"user"`
+}
+
+// MoveTaskRequestBody defines model for MoveTaskRequestBody.
+type MoveTaskRequestBody struct {
+	// NextId ID of the task that will be right below the moved task. Omit both neighbours to move the task to the end of the column
+	NextId *int `json:"nextId,omitempty"`
+
+	// PrevId ID of the task that will be right above the moved task
+	PrevId *int       `json:"prevId,omitempty"`
+	Status StatusName `json:"status"`
+}
+
+// MoveTaskToProjectRequestBody defines model for MoveTaskToProjectRequestBody.
+type MoveTaskToProjectRequestBody struct {
+	// ProjectId ID of the destination project. null moves the task to the inbox
+	ProjectId *int `json:"projectId"`
+}
+
+// PasswordForgotRequestBody defines model for PasswordForgotRequestBody.
+type PasswordForgotRequestBody struct {
+	Email string `json:"email"`
+}
+
+// PasswordResetRequestBody defines model for PasswordResetRequestBody.
+type PasswordResetRequestBody struct {
+	// Password The new password
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+// Priority defines model for Priority.
+type Priority string
+
+// Project defines model for Project.
+type Project struct {
+	Archived bool   `json:"archived"`
+	Color    Color  `json:"color"`
+	Id       int    `json:"id"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+}
+
+// ProjectDeleteMode defines model for ProjectDeleteMode.
+type ProjectDeleteMode string
+
+// ProjectResponse defines model for ProjectResponse.
+type ProjectResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       Project    `json:"data"`
+}
+
+// ProjectsResponse defines model for ProjectsResponse.
+type ProjectsResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       []Project  `json:"data"`
+}
+
+// Recurrence RFC 5545 RRULE subset (FREQ=DAILY|WEEKLY|MONTHLY|YEARLY, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, COUNT, UNTIL). When a recurring task is moved to done, the next occurrence is created with its deadline advanced
+type Recurrence = string
+
+// ReorderChecklistItemsRequestBody defines model for ReorderChecklistItemsRequestBody.
+type ReorderChecklistItemsRequestBody struct {
+	// ItemIds Every checklist item ID of the task in the new order
+	ItemIds []int `json:"itemIds"`
+}
+
+// SavedView defines model for SavedView.
+type SavedView struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Filter Filter and order of a task list. The fields work like the query parameters of GET /tasks
+	Filter TaskFilter `json:"filter"`
+	Id     int        `json:"id"`
+	Kind   string     `json:"kind"`
+	Name   string     `json:"name"`
+}
+
+// SavedViewResponse defines model for SavedViewResponse.
+type SavedViewResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       SavedView  `json:"data"`
+}
+
+// SavedViewsResponse defines model for SavedViewsResponse.
+type SavedViewsResponse struct {
+	ApiVersion ApiVersion  `json:"apiVersion"`
+	Data       []SavedView `json:"data"`
+}
+
+// SearchText defines model for SearchText.
+type SearchText = string
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether the request was made with this session
+	Current bool `json:"current"`
+
+	// Device User agent of the device that logged in
+	Device     string    `json:"device"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Id         int       `json:"id"`
+	Kind       string    `json:"kind"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+}
+
+// SessionsResponse defines model for SessionsResponse.
+type SessionsResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       []Session  `json:"data"`
+}
+
+// SignUpRequestBody defines model for SignUpRequestBody.
+type SignUpRequestBody struct {
+	Kind *string `json:"kind,omitempty"`
+	User User    `json:"user"`
+}
+
+// SignUpResponse defines model for SignUpResponse.
+type SignUpResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       User       `json:"data"`
+}
+
+// SnoozeTaskRequestBody defines model for SnoozeTaskRequestBody.
+type SnoozeTaskRequestBody struct {
+	// Until Hide the task from the task list until this time. null shows the task again right away
+	Until *time.Time `json:"until"`
+}
+
+// SortOrder Defaults to desc for relevance, otherwise asc
+type SortOrder string
+
+// StartAt The task is hidden from the task list until it can be started at this time
+type StartAt = time.Time
+
+// Status defines model for Status.
+type Status struct {
+	Id   *int       `json:"id,omitempty"`
+	Name StatusName `json:"name"`
+}
+
+// StatusName defines model for StatusName.
+type StatusName string
+
+// Tag defines model for Tag.
+type Tag struct {
+	Id   int    `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// TagResponse defines model for TagResponse.
+type TagResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       Tag        `json:"data"`
+}
+
+// TagsResponse defines model for TagsResponse.
+type TagsResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       []Tag      `json:"data"`
+}
+
+// Task defines model for Task.
+type Task struct {
+	// BlockedBy IDs of the tasks that must be done before this task can be started
+	BlockedBy []int           `json:"blockedBy"`
+	Checklist []ChecklistItem `json:"checklist"`
+
+	// CommentCount Number of comments on the task
+	CommentCount int `json:"commentCount"`
+
+	// CompletedAt When the task was moved to done. Absent unless the task is done or archived after being done
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// CompletionPercentage Percentage of checked checklist items. Absent when the task has no checklist
+	CompletionPercentage *int `json:"completionPercentage,omitempty"`
+
+	// Deadline Date of the deadline. Without dueTime the task is due at any time on this date
+	Deadline *Deadline `json:"deadline,omitempty"`
+
+	// DeletedAt When the task was moved to the trash. Absent unless the task is in the trash
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// Description Markdown text
+	Description *Description `json:"description,omitempty"`
+
+	// DescriptionHtml Description rendered from Markdown to sanitized HTML
+	DescriptionHtml *string `json:"descriptionHtml,omitempty"`
+
+	// DueAt The deadline as an instant in UTC. Absent for all-day deadlines
+	DueAt *time.Time `json:"dueAt,omitempty"`
+
+	// DueTime Time of day (HH:MM) in timeZone when the task is due. Requires deadline and timeZone. Updating deadline without dueTime makes it an all-day deadline
+	DueTime *DueTime `json:"dueTime,omitempty"`
+
+	// Estimate Estimated effort in minutes or story points. Omit to keep the current estimate on update
+	Estimate *Estimate `json:"estimate,omitempty"`
+
+	// Highlight Name and description fragment with the matched words wrapped in <mark>. They are HTML-escaped
+	Highlight *TaskHighlight `json:"highlight,omitempty"`
+	Id        int            `json:"id"`
+	Kind      string         `json:"kind"`
+	Name      string         `json:"name"`
+	Priority  Priority       `json:"priority"`
+
+	// ProjectId ID of the project the task belongs to. null when the task is in the inbox
+	ProjectId *int `json:"projectId"`
+
+	// Rank Position of the task within its status column. Tasks are ordered by comparing ranks as strings
+	Rank string `json:"rank"`
+
+	// Recurrence RFC 5545 RRULE subset (FREQ=DAILY|WEEKLY|MONTHLY|YEARLY, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, COUNT, UNTIL). When a recurring task is moved to done, the next occurrence is created with its deadline advanced
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+
+	// SnoozedUntil The task is hidden from the task list until this time
+	SnoozedUntil *time.Time `json:"snoozedUntil,omitempty"`
+
+	// StartAt The task is hidden from the task list until it can be started at this time
+	StartAt *StartAt `json:"startAt,omitempty"`
+	Status  Status   `json:"status"`
+	Tags    []Tag    `json:"tags"`
+
+	// TimeZone IANA time zone name. On tasks it is the zone the deadline is given in and requires deadline
+	TimeZone *TimeZone `json:"timeZone,omitempty"`
+
+	// TrackedSeconds Total seconds of finished time entries. A running timer is not included
+	TrackedSeconds int64 `json:"trackedSeconds"`
+
+	// WorkStartedAt When the task was first moved to inProgress. Absent if it never was
+	WorkStartedAt *time.Time `json:"workStartedAt,omitempty"`
+}
+
+// TaskEvent defines model for TaskEvent.
+type TaskEvent struct {
+	Changes   []FieldChange `json:"changes"`
+	CreatedAt time.Time     `json:"createdAt"`
+	Id        int           `json:"id"`
+	Kind      string        `json:"kind"`
+	TaskId    int           `json:"taskId"`
+	Type      TaskEventType `json:"type"`
+
+	// UserId User who performed the change
+	UserId int `json:"userId"`
+}
+
+// TaskEventType defines model for TaskEventType.
+type TaskEventType string
+
+// TaskEventsResponse defines model for TaskEventsResponse.
+type TaskEventsResponse struct {
+	ApiVersion ApiVersion  `json:"apiVersion"`
+	Data       []TaskEvent `json:"data"`
+
+	// NextCursor Cursor for the next page. null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+// TaskFilter Filter and order of a task list. The fields work like the query parameters of GET /tasks
+type TaskFilter struct {
+	// DeadlineFrom Date of the deadline. Without dueTime the task is due at any time on this date
+	DeadlineFrom *Deadline `json:"deadlineFrom,omitempty"`
+
+	// DeadlineTo Date of the deadline. Without dueTime the task is due at any time on this date
+	DeadlineTo *Deadline `json:"deadlineTo,omitempty"`
+
+	// DueWithinDays Only tasks due within this many days, starting today in the user's time zone. Saved views resolve it each time they are opened. Not available on GET /tasks
+	DueWithinDays  *int  `json:"dueWithinDays,omitempty"`
+	Inbox          *bool `json:"inbox,omitempty"`
+	IncludeSnoozed *bool `json:"includeSnoozed,omitempty"`
+
+	// Order Defaults to desc for relevance, otherwise asc
+	Order      *SortOrder  `json:"order,omitempty"`
+	Priorities *[]Priority `json:"priorities,omitempty"`
+	ProjectId  *int        `json:"projectId,omitempty"`
+
+	// Sort position orders by status column, then by the manual order within the column. relevance orders by full-text search rank and requires text. Defaults to relevance when text is given, otherwise position
+	Sort     *TaskSortField `json:"sort,omitempty"`
+	Statuses *[]StatusName  `json:"statuses,omitempty"`
+
+	// TagIds Only tasks that have all of these tags
+	TagIds *[]int      `json:"tagIds,omitempty"`
+	Text   *SearchText `json:"text,omitempty"`
+}
+
+// TaskHighlight Name and description fragment with the matched words wrapped in <mark>. They are HTML-escaped
+type TaskHighlight struct {
+	Description string `json:"description"`
+	Name        string `json:"name"`
+}
+
+// TaskResponse defines model for TaskResponse.
+type TaskResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       Task       `json:"data"`
+}
+
+// TaskSortField position orders by status column, then by the manual order within the column. relevance orders by full-text search rank and requires text. Defaults to relevance when text is given, otherwise position
+type TaskSortField string
+
+// TasksResponse defines model for TasksResponse.
+type TasksResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       []Task     `json:"data"`
+
+	// NextCursor Cursor for the next page. null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+// TimeEntriesResponse defines model for TimeEntriesResponse.
+type TimeEntriesResponse struct {
+	ApiVersion ApiVersion  `json:"apiVersion"`
+	Data       []TimeEntry `json:"data"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// DurationSeconds Length of the entry in seconds. 0 while the timer is running
+	DurationSeconds int64 `json:"durationSeconds"`
+
+	// EndedAt null while the timer is running
+	EndedAt   *time.Time `json:"endedAt"`
+	Id        int        `json:"id"`
+	Kind      string     `json:"kind"`
+	Note      string     `json:"note"`
+	StartedAt time.Time  `json:"startedAt"`
+	TaskId    int        `json:"taskId"`
+}
+
+// TimeEntryResponse defines model for TimeEntryResponse.
+type TimeEntryResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       TimeEntry  `json:"data"`
+}
+
+// TimeZone IANA time zone name. On tasks it is the zone the deadline is given in and requires deadline
+type TimeZone = string
+
+// TimerResponse defines model for TimerResponse.
+type TimerResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Data The running timer. null when no timer is running
+	Data *TimeEntry `json:"data"`
+}
+
+// UpdateChecklistItemRequestBody defines model for UpdateChecklistItemRequestBody.
+type UpdateChecklistItemRequestBody struct {
+	Kind *string `json:"kind,omitempty"`
+	Text string  `json:"text"`
+}
+
+// UpdateCommentRequestBody defines model for UpdateCommentRequestBody.
+type UpdateCommentRequestBody struct {
+	Body string  `json:"body"`
+	Kind *string `json:"kind,omitempty"`
+}
+
+// UpdateProjectRequestBody defines model for UpdateProjectRequestBody.
+type UpdateProjectRequestBody struct {
+	Color *Color  `json:"color,omitempty"`
+	Kind  *string `json:"kind,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// UpdateSavedViewRequestBody defines model for UpdateSavedViewRequestBody.
+type UpdateSavedViewRequestBody struct {
+	// Filter Filter and order of a task list. The fields work like the query parameters of GET /tasks
+	Filter *TaskFilter `json:"filter,omitempty"`
+	Kind   *string     `json:"kind,omitempty"`
+	Name   *string     `json:"name,omitempty"`
+}
+
+// UpdateTagRequestBody defines model for UpdateTagRequestBody.
+type UpdateTagRequestBody struct {
+	Kind *string `json:"kind,omitempty"`
+	Name string  `json:"name"`
+}
+
+// UpdateTaskRequestBody defines model for UpdateTaskRequestBody.
+type UpdateTaskRequestBody struct {
+	// Clear Fields to remove from the task. Omitted fields keep their current values, so use this to remove one
+	Clear *[]ClearField `json:"clear,omitempty"`
+
+	// Deadline Date of the deadline. Without dueTime the task is due at any time on this date
+	Deadline *Deadline `json:"deadline,omitempty"`
+
+	// Description Markdown text
+	Description *Description `json:"description,omitempty"`
+
+	// DueTime Time of day (HH:MM) in timeZone when the task is due. Requires deadline and timeZone. Updating deadline without dueTime makes it an all-day deadline
+	DueTime *DueTime `json:"dueTime,omitempty"`
+
+	// Estimate Estimated effort in minutes or story points. Omit to keep the current estimate on update
+	Estimate *Estimate `json:"estimate,omitempty"`
+	Kind     *string   `json:"kind,omitempty"`
+	Name     string    `json:"name"`
+	Priority *Priority `json:"priority,omitempty"`
+
+	// ProjectId ID of the project the task belongs to
+	ProjectId *int `json:"projectId,omitempty"`
+
+	// Recurrence RFC 5545 RRULE subset (FREQ=DAILY|WEEKLY|MONTHLY|YEARLY, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, COUNT, UNTIL). When a recurring task is moved to done, the next occurrence is created with its deadline advanced
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+
+	// StartAt The task is hidden from the task list until it can be started at this time
+	StartAt *StartAt `json:"startAt,omitempty"`
+	Status  Status   `json:"status"`
+
+	// TagIds IDs of the tags attached to the task. Omit to keep the current tags
+	TagIds *[]int `json:"tagIds,omitempty"`
+
+	// TimeZone IANA time zone name. On tasks it is the zone the deadline is given in and requires deadline
+	TimeZone *TimeZone `json:"timeZone,omitempty"`
+}
+
+// UpdateTimeEntryRequestBody defines model for UpdateTimeEntryRequestBody.
+type UpdateTimeEntryRequestBody struct {
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+	Note      *string    `json:"note,omitempty"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+}
+
+// UploadAttachmentRequestBody defines model for UploadAttachmentRequestBody.
+type UploadAttachmentRequestBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt *openapi_types.Date `json:"created_at,omitempty"`
+	Email     string              `json:"email"`
+
+	// EmailVerifiedAt When the email address was verified, or null while it is unverified
+	EmailVerifiedAt *time.Time `json:"emailVerifiedAt"`
+	Id              *int       `json:"id,omitempty"`
+	Kind            string     `json:"kind"`
+	Password        *string    `json:"password,omitempty"`
+
+	// TimeZone IANA time zone name. On tasks it is the zone the deadline is given in and requires deadline
+	TimeZone *TimeZone `json:"timeZone,omitempty"`
+}
+
+// VerifyEmailRequestBody defines model for VerifyEmailRequestBody.
+type VerifyEmailRequestBody struct {
+	Token string `json:"token"`
+}
+
+// GetAllProjectsParams defines parameters for GetAllProjects.
+type GetAllProjectsParams struct {
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
+// DeleteProjectByIdParams defines parameters for DeleteProjectById.
+type DeleteProjectByIdParams struct {
+	// Mode inbox moves the tasks of the project to the inbox, cascade moves them to the trash together with the project
+	Mode *ProjectDeleteMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// GetEstimateReportParams defines parameters for GetEstimateReport.
+type GetEstimateReportParams struct {
+	// From First day of the period. Defaults to 12 weeks before the end of the period
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day of the period. Defaults to the end of the current week
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetAllTasksParams defines parameters for GetAllTasks.
+type GetAllTasksParams struct {
+	// Status Filter by status names
+	Status *[]StatusName `form:"status,omitempty" json:"status,omitempty"`
+
+	// Priority Filter by priorities
+	Priority *[]Priority `form:"priority,omitempty" json:"priority,omitempty"`
+
+	// TagId Only tasks that have all of these tags
+	TagId *[]int `form:"tagId,omitempty" json:"tagId,omitempty"`
+
+	// ProjectId Only tasks in this project
+	ProjectId *int `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// Inbox Only tasks that do not belong to any project
+	Inbox *bool `form:"inbox,omitempty" json:"inbox,omitempty"`
+
+	// IncludeSnoozed Also include tasks that are snoozed or whose start date has not come yet. They are hidden by default
+	IncludeSnoozed *bool `form:"includeSnoozed,omitempty" json:"includeSnoozed,omitempty"`
+
+	// DeadlineFrom Only tasks whose deadline is on or after this date
+	DeadlineFrom *Deadline `form:"deadlineFrom,omitempty" json:"deadlineFrom,omitempty"`
+
+	// DeadlineTo Only tasks whose deadline is on or before this date
+	DeadlineTo *Deadline `form:"deadlineTo,omitempty" json:"deadlineTo,omitempty"`
+
+	// Text Full-text search over names and descriptions. Only tasks containing every word are returned, ordered by relevance unless sort is given, with the matches highlighted
+	Text  *SearchText    `form:"text,omitempty" json:"text,omitempty"`
+	Sort  *TaskSortField `form:"sort,omitempty" json:"sort,omitempty"`
+	Order *SortOrder     `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Opaque cursor returned as nextCursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SearchTasksParams defines parameters for SearchTasks.
+type SearchTasksParams struct {
+	Q     string         `form:"q" json:"q"`
+	Sort  *TaskSortField `form:"sort,omitempty" json:"sort,omitempty"`
+	Order *SortOrder     `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Opaque cursor returned as nextCursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTaskHistoryParams defines parameters for GetTaskHistory.
+type GetTaskHistoryParams struct {
+	// Cursor Opaque cursor returned as nextCursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetOverdueViewParams defines parameters for GetOverdueView.
+type GetOverdueViewParams struct {
+	// TimeZone IANA time zone used instead of the user's time zone to decide what today is
+	TimeZone *TimeZone `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// GetSomedayViewParams defines parameters for GetSomedayView.
+type GetSomedayViewParams struct {
+	// TimeZone IANA time zone used instead of the user's time zone to decide what today is
+	TimeZone *TimeZone `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// GetTodayViewParams defines parameters for GetTodayView.
+type GetTodayViewParams struct {
+	// TimeZone IANA time zone used instead of the user's time zone to decide what today is
+	TimeZone *TimeZone `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// GetUpcomingViewParams defines parameters for GetUpcomingView.
+type GetUpcomingViewParams struct {
+	// Days Number of days after today to include
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+
+	// TimeZone IANA time zone used instead of the user's time zone to decide what today is
+	TimeZone *TimeZone `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// GetSavedViewTasksParams defines parameters for GetSavedViewTasks.
+type GetSavedViewTasksParams struct {
+	// Cursor Opaque cursor returned as nextCursor by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
+type PostLoginJSONRequestBody = LoginRequestBody
+
+// PostPasswordForgotJSONRequestBody defines body for PostPasswordForgot for application/json ContentType.
+type PostPasswordForgotJSONRequestBody = PasswordForgotRequestBody
+
+// PostPasswordResetJSONRequestBody defines body for PostPasswordReset for application/json ContentType.
+type PostPasswordResetJSONRequestBody = PasswordResetRequestBody
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = CreateProjectRequestBody
+
+// UpdateProjectByIdJSONRequestBody defines body for UpdateProjectById for application/json ContentType.
+type UpdateProjectByIdJSONRequestBody = UpdateProjectRequestBody
+
+// PostSignUpJSONRequestBody defines body for PostSignUp for application/json ContentType.
+type PostSignUpJSONRequestBody = SignUpRequestBody
+
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = CreateTagRequestBody
+
+// UpdateTagByIdJSONRequestBody defines body for UpdateTagById for application/json ContentType.
+type UpdateTagByIdJSONRequestBody = UpdateTagRequestBody
+
+// CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
+type CreateTaskJSONRequestBody = CreateTaskRequestBody
+
+// UpdateTaskByIdJSONRequestBody defines body for UpdateTaskById for application/json ContentType.
+type UpdateTaskByIdJSONRequestBody = UpdateTaskRequestBody
+
+// UploadAttachmentMultipartRequestBody defines body for UploadAttachment for multipart/form-data ContentType.
+type UploadAttachmentMultipartRequestBody = UploadAttachmentRequestBody
+
+// CreateChecklistItemJSONRequestBody defines body for CreateChecklistItem for application/json ContentType.
+type CreateChecklistItemJSONRequestBody = CreateChecklistItemRequestBody
+
+// ReorderChecklistItemsJSONRequestBody defines body for ReorderChecklistItems for application/json ContentType.
+type ReorderChecklistItemsJSONRequestBody = ReorderChecklistItemsRequestBody
+
+// UpdateChecklistItemJSONRequestBody defines body for UpdateChecklistItem for application/json ContentType.
+type UpdateChecklistItemJSONRequestBody = UpdateChecklistItemRequestBody
+
+// CreateCommentJSONRequestBody defines body for CreateComment for application/json ContentType.
+type CreateCommentJSONRequestBody = CreateCommentRequestBody
+
+// UpdateCommentJSONRequestBody defines body for UpdateComment for application/json ContentType.
+type UpdateCommentJSONRequestBody = UpdateCommentRequestBody
+
+// CreateTaskDependencyJSONRequestBody defines body for CreateTaskDependency for application/json ContentType.
+type CreateTaskDependencyJSONRequestBody = CreateTaskDependencyRequestBody
+
+// MoveTaskJSONRequestBody defines body for MoveTask for application/json ContentType.
+type MoveTaskJSONRequestBody = MoveTaskRequestBody
+
+// MoveTaskToProjectJSONRequestBody defines body for MoveTaskToProject for application/json ContentType.
+type MoveTaskToProjectJSONRequestBody = MoveTaskToProjectRequestBody
+
+// SnoozeTaskJSONRequestBody defines body for SnoozeTask for application/json ContentType.
+type SnoozeTaskJSONRequestBody = SnoozeTaskRequestBody
+
+// CreateTimeEntryJSONRequestBody defines body for CreateTimeEntry for application/json ContentType.
+type CreateTimeEntryJSONRequestBody = CreateTimeEntryRequestBody
+
+// UpdateTimeEntryJSONRequestBody defines body for UpdateTimeEntry for application/json ContentType.
+type UpdateTimeEntryJSONRequestBody = UpdateTimeEntryRequestBody
+
+// PostVerifyEmailJSONRequestBody defines body for PostVerifyEmail for application/json ContentType.
+type PostVerifyEmailJSONRequestBody = VerifyEmailRequestBody
+
+// CreateSavedViewJSONRequestBody defines body for CreateSavedView for application/json ContentType.
+type CreateSavedViewJSONRequestBody = CreateSavedViewRequestBody
+
+// UpdateSavedViewByIdJSONRequestBody defines body for UpdateSavedViewById for application/json ContentType.
+type UpdateSavedViewByIdJSONRequestBody = UpdateSavedViewRequestBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get CSRF token
+	// (GET /csrf)
+	GetCsrfToken(c *gin.Context)
+	// Login
+	// (POST /login)
+	PostLogin(c *gin.Context)
+	// Logout
+	// (POST /logout)
+	PostLogout(c *gin.Context)
+	// Request a password reset link
+	// (POST /password/forgot)
+	PostPasswordForgot(c *gin.Context)
+	// Reset the password
+	// (POST /password/reset)
+	PostPasswordReset(c *gin.Context)
+	// Get all projects
+	// (GET /projects)
+	GetAllProjects(c *gin.Context, params GetAllProjectsParams)
+	// Create a new project
+	// (POST /projects)
+	CreateProject(c *gin.Context)
+	// Delete project by ID
+	// (DELETE /projects/{id})
+	DeleteProjectById(c *gin.Context, id int, params DeleteProjectByIdParams)
+	// Get project by ID
+	// (GET /projects/{id})
+	GetProjectById(c *gin.Context, id int)
+	// Update project by ID
+	// (PATCH /projects/{id})
+	UpdateProjectById(c *gin.Context, id int)
+	// Archive a project
+	// (POST /projects/{id}/archive)
+	ArchiveProject(c *gin.Context, id int)
+	// Unarchive a project
+	// (POST /projects/{id}/unarchive)
+	UnarchiveProject(c *gin.Context, id int)
+	// Compare estimates with the actual cycle time of completed tasks per user and week
+	// (GET /reports/estimates)
+	GetEstimateReport(c *gin.Context, params GetEstimateReportParams)
+	// Get the active sessions of the user
+	// (GET /sessions)
+	GetSessions(c *gin.Context)
+	// Revoke a session
+	// (DELETE /sessions/{id})
+	DeleteSessionById(c *gin.Context, id int)
+	// Sign up
+	// (POST /signup)
+	PostSignUp(c *gin.Context)
+	// Get all tags
+	// (GET /tags)
+	GetAllTags(c *gin.Context)
+	// Create a new tag
+	// (POST /tags)
+	CreateTag(c *gin.Context)
+	// Delete tag by ID
+	// (DELETE /tags/{id})
+	DeleteTagById(c *gin.Context, id int)
+	// Get tag by ID
+	// (GET /tags/{id})
+	GetTagById(c *gin.Context, id int)
+	// Update tag by ID
+	// (PATCH /tags/{id})
+	UpdateTagById(c *gin.Context, id int)
+	// Get all tasks
+	// (GET /tasks)
+	GetAllTasks(c *gin.Context, params GetAllTasksParams)
+	// Create a new task
+	// (POST /tasks)
+	CreateTask(c *gin.Context)
+	// Search tasks with a query
+	// (GET /tasks/search)
+	SearchTasks(c *gin.Context, params SearchTasksParams)
+	// Move task to the trash
+	// (DELETE /tasks/{id})
+	DeleteTaskById(c *gin.Context, id int)
+	// Get task by ID
+	// (GET /tasks/{id})
+	GetTaskById(c *gin.Context, id int)
+	// Update task by ID
+	// (PATCH /tasks/{id})
+	UpdateTaskById(c *gin.Context, id int)
+	// Get the attachments of a task
+	// (GET /tasks/{id}/attachments)
+	GetTaskAttachments(c *gin.Context, id int)
+	// Upload a file and attach it to a task
+	// (POST /tasks/{id}/attachments)
+	UploadAttachment(c *gin.Context, id int)
+	// Delete an attachment
+	// (DELETE /tasks/{id}/attachments/{attachmentId})
+	DeleteAttachment(c *gin.Context, id int, attachmentId int)
+	// Download the content of an attachment
+	// (GET /tasks/{id}/attachments/{attachmentId}/content)
+	DownloadAttachment(c *gin.Context, id int, attachmentId int)
+	// Add a checklist item to a task
+	// (POST /tasks/{id}/checklist)
+	CreateChecklistItem(c *gin.Context, id int)
+	// Reorder the checklist items of a task
+	// (PUT /tasks/{id}/checklist/order)
+	ReorderChecklistItems(c *gin.Context, id int)
+	// Delete a checklist item
+	// (DELETE /tasks/{id}/checklist/{itemId})
+	DeleteChecklistItem(c *gin.Context, id int, itemId int)
+	// Update a checklist item
+	// (PATCH /tasks/{id}/checklist/{itemId})
+	UpdateChecklistItem(c *gin.Context, id int, itemId int)
+	// Check a checklist item
+	// (POST /tasks/{id}/checklist/{itemId}/check)
+	CheckChecklistItem(c *gin.Context, id int, itemId int)
+	// Uncheck a checklist item
+	// (POST /tasks/{id}/checklist/{itemId}/uncheck)
+	UncheckChecklistItem(c *gin.Context, id int, itemId int)
+	// Get the comments on a task, oldest first
+	// (GET /tasks/{id}/comments)
+	GetTaskComments(c *gin.Context, id int)
+	// Add a comment to a task
+	// (POST /tasks/{id}/comments)
+	CreateComment(c *gin.Context, id int)
+	// Delete a comment. Only the author can delete it
+	// (DELETE /tasks/{id}/comments/{commentId})
+	DeleteComment(c *gin.Context, id int, commentId int)
+	// Edit a comment. Only the author can edit it
+	// (PATCH /tasks/{id}/comments/{commentId})
+	UpdateComment(c *gin.Context, id int, commentId int)
+	// Mark a task as blocked by another task
+	// (POST /tasks/{id}/dependencies)
+	CreateTaskDependency(c *gin.Context, id int)
+	// Remove a dependency from a task
+	// (DELETE /tasks/{id}/dependencies/{blockerId})
+	DeleteTaskDependency(c *gin.Context, id int, blockerId int)
+	// Get the change history of a task in chronological order
+	// (GET /tasks/{id}/history)
+	GetTaskHistory(c *gin.Context, id int, params GetTaskHistoryParams)
+	// Move a task within or across status columns
+	// (POST /tasks/{id}/move)
+	MoveTask(c *gin.Context, id int)
+	// Move a task to another project or back to the inbox
+	// (PUT /tasks/{id}/project)
+	MoveTaskToProject(c *gin.Context, id int)
+	// Snooze a task until a future time, or wake it up
+	// (POST /tasks/{id}/snooze)
+	SnoozeTask(c *gin.Context, id int)
+	// Get the time entries of a task, oldest first
+	// (GET /tasks/{id}/time-entries)
+	GetTaskTimeEntries(c *gin.Context, id int)
+	// Log time spent on a task manually
+	// (POST /tasks/{id}/time-entries)
+	CreateTimeEntry(c *gin.Context, id int)
+	// Delete a time entry
+	// (DELETE /tasks/{id}/time-entries/{entryId})
+	DeleteTimeEntry(c *gin.Context, id int, entryId int)
+	// Edit a time entry. Setting endedAt on a running entry stops the timer
+	// (PATCH /tasks/{id}/time-entries/{entryId})
+	UpdateTimeEntry(c *gin.Context, id int, entryId int)
+	// Start a timer on a task. Only one timer can run at a time
+	// (POST /tasks/{id}/timer)
+	StartTimer(c *gin.Context, id int)
+	// Get the running timer
+	// (GET /timer)
+	GetTimer(c *gin.Context)
+	// Stop the running timer
+	// (POST /timer/stop)
+	StopTimer(c *gin.Context)
+	// Refresh the access token
+	// (POST /token/refresh)
+	PostTokenRefresh(c *gin.Context)
+	// Get tasks in the trash
+	// (GET /trash)
+	GetTrash(c *gin.Context)
+	// Permanently delete a task in the trash
+	// (DELETE /trash/{id})
+	DeleteTrashedTask(c *gin.Context, id int)
+	// Restore a task from the trash
+	// (POST /trash/{id}/restore)
+	RestoreTask(c *gin.Context, id int)
+	// Verify the email address
+	// (POST /verify-email)
+	PostVerifyEmail(c *gin.Context)
+	// Resend the verification email
+	// (POST /verify-email/resend)
+	PostVerifyEmailResend(c *gin.Context)
+	// Get the saved views of the user
+	// (GET /views)
+	GetSavedViews(c *gin.Context)
+	// Save a named task filter as a view
+	// (POST /views)
+	CreateSavedView(c *gin.Context)
+	// Get overdue tasks
+	// (GET /views/overdue)
+	GetOverdueView(c *gin.Context, params GetOverdueViewParams)
+	// Get tasks without a deadline
+	// (GET /views/someday)
+	GetSomedayView(c *gin.Context, params GetSomedayViewParams)
+	// Get tasks due today
+	// (GET /views/today)
+	GetTodayView(c *gin.Context, params GetTodayViewParams)
+	// Get tasks due in the next days
+	// (GET /views/upcoming)
+	GetUpcomingView(c *gin.Context, params GetUpcomingViewParams)
+	// Delete saved view by ID
+	// (DELETE /views/{id})
+	DeleteSavedViewById(c *gin.Context, id int)
+	// Get saved view by ID
+	// (GET /views/{id})
+	GetSavedViewById(c *gin.Context, id int)
+	// Update saved view by ID
+	// (PATCH /views/{id})
+	UpdateSavedViewById(c *gin.Context, id int)
+	// Get the tasks matching a saved view
+	// (GET /views/{id}/tasks)
+	GetSavedViewTasks(c *gin.Context, id int, params GetSavedViewTasksParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetCsrfToken operation middleware
+func (siw *ServerInterfaceWrapper) GetCsrfToken(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCsrfToken(c)
+}
+
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogin(c)
+}
+
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogout(c)
+}
+
+// PostPasswordForgot operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordForgot(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPasswordForgot(c)
+}
+
+// PostPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordReset(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPasswordReset(c)
+}
+
+// GetAllProjects operation middleware
+func (siw *ServerInterfaceWrapper) GetAllProjects(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllProjectsParams
+
+	// ------------- Optional query parameter "includeArchived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", c.Request.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeArchived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAllProjects(c, params)
+}
+
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateProject(c)
+}
+
+// DeleteProjectById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteProjectByIdParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", c.Request.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProjectById(c, id, params)
+}
+
+// GetProjectById operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProjectById(c, id)
+}
+
+// UpdateProjectById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateProjectById(c, id)
+}
+
+// ArchiveProject operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ArchiveProject(c, id)
+}
+
+// UnarchiveProject operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UnarchiveProject(c, id)
+}
+
+// GetEstimateReport operation middleware
+func (siw *ServerInterfaceWrapper) GetEstimateReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEstimateReportParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEstimateReport(c, params)
+}
+
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSessions(c)
+}
+
+// DeleteSessionById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSessionById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSessionById(c, id)
+}
+
+// PostSignUp operation middleware
+func (siw *ServerInterfaceWrapper) PostSignUp(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSignUp(c)
+}
+
+// GetAllTags operation middleware
+func (siw *ServerInterfaceWrapper) GetAllTags(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAllTags(c)
+}
+
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTag(c)
+}
+
+// DeleteTagById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTagById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTagById(c, id)
+}
+
+// GetTagById operation middleware
+func (siw *ServerInterfaceWrapper) GetTagById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTagById(c, id)
+}
+
+// UpdateTagById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTagById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTagById(c, id)
+}
+
+// GetAllTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetAllTasks(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllTasksParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", c.Request.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter priority: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tagId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagId", c.Request.URL.Query(), &params.TagId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", c.Request.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "inbox" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inbox", c.Request.URL.Query(), &params.Inbox)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter inbox: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "includeSnoozed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeSnoozed", c.Request.URL.Query(), &params.IncludeSnoozed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeSnoozed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "deadlineFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deadlineFrom", c.Request.URL.Query(), &params.DeadlineFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deadlineFrom: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "deadlineTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deadlineTo", c.Request.URL.Query(), &params.DeadlineTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deadlineTo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "text" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "text", c.Request.URL.Query(), &params.Text)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter text: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAllTasks(c, params)
+}
+
+// CreateTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTask(c)
+}
+
+// SearchTasks operation middleware
+func (siw *ServerInterfaceWrapper) SearchTasks(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchTasksParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchTasks(c, params)
+}
+
+// DeleteTaskById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTaskById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTaskById(c, id)
+}
+
+// GetTaskById operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskById(c, id)
+}
+
+// UpdateTaskById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTaskById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTaskById(c, id)
+}
+
+// GetTaskAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskAttachments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskAttachments(c, id)
+}
+
+// UploadAttachment operation middleware
+func (siw *ServerInterfaceWrapper) UploadAttachment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UploadAttachment(c, id)
+}
+
+// DeleteAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAttachment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", c.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachmentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAttachment(c, id, attachmentId)
+}
+
+// DownloadAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DownloadAttachment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", c.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachmentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DownloadAttachment(c, id, attachmentId)
+}
+
+// CreateChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateChecklistItem(c, id)
+}
+
+// ReorderChecklistItems operation middleware
+func (siw *ServerInterfaceWrapper) ReorderChecklistItems(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReorderChecklistItems(c, id)
+}
+
+// DeleteChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteChecklistItem(c, id, itemId)
+}
+
+// UpdateChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateChecklistItem(c, id, itemId)
+}
+
+// CheckChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) CheckChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CheckChecklistItem(c, id, itemId)
+}
+
+// UncheckChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) UncheckChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UncheckChecklistItem(c, id, itemId)
+}
+
+// GetTaskComments operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskComments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskComments(c, id)
+}
+
+// CreateComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateComment(c, id)
+}
+
+// DeleteComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", c.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter commentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteComment(c, id, commentId)
+}
+
+// UpdateComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", c.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter commentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateComment(c, id, commentId)
+}
+
+// CreateTaskDependency operation middleware
+func (siw *ServerInterfaceWrapper) CreateTaskDependency(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTaskDependency(c, id)
+}
+
+// DeleteTaskDependency operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTaskDependency(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "blockerId" -------------
+	var blockerId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blockerId", c.Param("blockerId"), &blockerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter blockerId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTaskDependency(c, id, blockerId)
+}
+
+// GetTaskHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskHistoryParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskHistory(c, id, params)
+}
+
+// MoveTask operation middleware
+func (siw *ServerInterfaceWrapper) MoveTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MoveTask(c, id)
+}
+
+// MoveTaskToProject operation middleware
+func (siw *ServerInterfaceWrapper) MoveTaskToProject(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MoveTaskToProject(c, id)
+}
+
+// SnoozeTask operation middleware
+func (siw *ServerInterfaceWrapper) SnoozeTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SnoozeTask(c, id)
+}
+
+// GetTaskTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskTimeEntries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaskTimeEntries(c, id)
+}
+
+// CreateTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateTimeEntry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTimeEntry(c, id)
+}
+
+// DeleteTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeEntry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryId", c.Param("entryId"), &entryId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter entryId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTimeEntry(c, id, entryId)
+}
+
+// UpdateTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTimeEntry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "entryId" -------------
+	var entryId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryId", c.Param("entryId"), &entryId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter entryId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTimeEntry(c, id, entryId)
+}
+
+// StartTimer operation middleware
+func (siw *ServerInterfaceWrapper) StartTimer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartTimer(c, id)
+}
+
+// GetTimer operation middleware
+func (siw *ServerInterfaceWrapper) GetTimer(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTimer(c)
+}
+
+// StopTimer operation middleware
+func (siw *ServerInterfaceWrapper) StopTimer(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StopTimer(c)
 }
 
-// CsrfToken defines model for CsrfToken.
-type CsrfToken = string
+// PostTokenRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostTokenRefresh(c *gin.Context) {
 
-// CsrfTokenResponse defines model for CsrfTokenResponse.
-type CsrfTokenResponse struct {
-	ApiVersion ApiVersion `json:"apiVersion"`
-	Data       CsrfToken  `json:"data"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTokenRefresh(c)
 }
 
-// Deadline defines model for Deadline.
-type Deadline = openapi_types.Date
+// GetTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetTrash(c *gin.Context) {
 
-// Error defines model for Error.
-type Error struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Error Error `json:"error"`
+	siw.Handler.GetTrash(c)
 }
 
-// LoginRequestBody defines model for LoginRequestBody.
-type LoginRequestBody struct {
-	Kind *string `json:"kind,omitempty"`
-	User User    `json:"user"`
-}
+// DeleteTrashedTask operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTrashedTask(c *gin.Context) {
 
-// SignUpRequestBody defines model for SignUpRequestBody.
-type SignUpRequestBody struct {
-	Kind *string `json:"kind,omitempty"`
-	User User    `json:"user"`
-}
+	var err error
 
-// SignUpResponse defines model for SignUpResponse.
-type SignUpResponse struct {
-	ApiVersion ApiVersion `json:"apiVersion"`
-	Data       User       `json:"data"`
-}
+	// ------------- Path parameter "id" -------------
+	var id int
 
-// Status defines model for Status.
-type Status struct {
-	Id   *int       `json:"id,omitempty"`
-	Name StatusName `json:"name"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// StatusName defines model for Status.Name.
-type StatusName string
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// Task defines model for Task.
-type Task struct {
-	Deadline *Deadline `json:"deadline,omitempty"`
-	Id       int       `json:"id"`
-	Kind     string    `json:"kind"`
-	Name     string    `json:"name"`
-	Status   Status    `json:"status"`
+	siw.Handler.DeleteTrashedTask(c, id)
 }
 
-// TaskResponse defines model for TaskResponse.
-type TaskResponse struct {
-	ApiVersion ApiVersion `json:"apiVersion"`
-	Data       Task       `json:"data"`
-}
+// RestoreTask operation middleware
+func (siw *ServerInterfaceWrapper) RestoreTask(c *gin.Context) {
 
-// TasksResponse defines model for TasksResponse.
-type TasksResponse struct {
-	ApiVersion ApiVersion `json:"apiVersion"`
-	Data       []Task     `json:"data"`
-}
+	var err error
 
-// UpdateTaskRequestBody defines model for UpdateTaskRequestBody.
-type UpdateTaskRequestBody struct {
-	Deadline *Deadline `json:"deadline,omitempty"`
-	Kind     *string   `json:"kind,omitempty"`
-	Name     string    `json:"name"`
-	Status   Status    `json:"status"`
-}
+	// ------------- Path parameter "id" -------------
+	var id int
 
-// User defines model for User.
-type User struct {
-	CreatedAt *openapi_types.Date `json:"created_at,omitempty"`
-	Email     string              `json:"email"`
-	Id        *int                `json:"id,omitempty"`
-	Kind      string              `json:"kind"`
-	Password  *string             `json:"password,omitempty"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
-type PostLoginJSONRequestBody = LoginRequestBody
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// PostSignUpJSONRequestBody defines body for PostSignUp for application/json ContentType.
-type PostSignUpJSONRequestBody = SignUpRequestBody
+	siw.Handler.RestoreTask(c, id)
+}
 
-// CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
-type CreateTaskJSONRequestBody = CreateTaskRequestBody
+// PostVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) PostVerifyEmail(c *gin.Context) {
 
-// UpdateTaskByIdJSONRequestBody defines body for UpdateTaskById for application/json ContentType.
-type UpdateTaskByIdJSONRequestBody = UpdateTaskRequestBody
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get CSRF token
-	// (GET /csrf)
-	GetCsrfToken(c *gin.Context)
-	// Login
-	// (POST /login)
-	PostLogin(c *gin.Context)
-	// Logout
-	// (POST /logout)
-	PostLogout(c *gin.Context)
-	// Sign up
-	// (POST /signup)
-	PostSignUp(c *gin.Context)
-	// Get all tasks
-	// (GET /tasks)
-	GetAllTasks(c *gin.Context)
-	// Create a new task
-	// (POST /tasks)
-	CreateTask(c *gin.Context)
-	// Delete task by ID
-	// (DELETE /tasks/{id})
-	DeleteTaskById(c *gin.Context, id int)
-	// Get task by ID
-	// (GET /tasks/{id})
-	GetTaskById(c *gin.Context, id int)
-	// Update task by ID
-	// (PATCH /tasks/{id})
-	UpdateTaskById(c *gin.Context, id int)
+	siw.Handler.PostVerifyEmail(c)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+// PostVerifyEmailResend operation middleware
+func (siw *ServerInterfaceWrapper) PostVerifyEmailResend(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostVerifyEmailResend(c)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// GetSavedViews operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedViews(c *gin.Context) {
 
-// GetCsrfToken operation middleware
-func (siw *ServerInterfaceWrapper) GetCsrfToken(c *gin.Context) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSavedViews(c)
+}
+
+// CreateSavedView operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavedView(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -189,11 +2750,24 @@ func (siw *ServerInterfaceWrapper) GetCsrfToken(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCsrfToken(c)
+	siw.Handler.CreateSavedView(c)
 }
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+// GetOverdueView operation middleware
+func (siw *ServerInterfaceWrapper) GetOverdueView(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOverdueViewParams
+
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", c.Request.URL.Query(), &params.TimeZone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeZone: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -202,11 +2776,24 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.GetOverdueView(c, params)
 }
 
-// PostLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
+// GetSomedayView operation middleware
+func (siw *ServerInterfaceWrapper) GetSomedayView(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSomedayViewParams
+
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", c.Request.URL.Query(), &params.TimeZone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeZone: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -215,11 +2802,24 @@ func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogout(c)
+	siw.Handler.GetSomedayView(c, params)
 }
 
-// PostSignUp operation middleware
-func (siw *ServerInterfaceWrapper) PostSignUp(c *gin.Context) {
+// GetTodayView operation middleware
+func (siw *ServerInterfaceWrapper) GetTodayView(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodayViewParams
+
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", c.Request.URL.Query(), &params.TimeZone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeZone: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -228,11 +2828,32 @@ func (siw *ServerInterfaceWrapper) PostSignUp(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSignUp(c)
+	siw.Handler.GetTodayView(c, params)
 }
 
-// GetAllTasks operation middleware
-func (siw *ServerInterfaceWrapper) GetAllTasks(c *gin.Context) {
+// GetUpcomingView operation middleware
+func (siw *ServerInterfaceWrapper) GetUpcomingView(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUpcomingViewParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", c.Request.URL.Query(), &params.TimeZone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timeZone: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -241,11 +2862,22 @@ func (siw *ServerInterfaceWrapper) GetAllTasks(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAllTasks(c)
+	siw.Handler.GetUpcomingView(c, params)
 }
 
-// CreateTask operation middleware
-func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
+// DeleteSavedViewById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedViewById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -254,11 +2886,11 @@ func (siw *ServerInterfaceWrapper) CreateTask(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateTask(c)
+	siw.Handler.DeleteSavedViewById(c, id)
 }
 
-// DeleteTaskById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTaskById(c *gin.Context) {
+// GetSavedViewById operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedViewById(c *gin.Context) {
 
 	var err error
 
@@ -278,11 +2910,11 @@ func (siw *ServerInterfaceWrapper) DeleteTaskById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteTaskById(c, id)
+	siw.Handler.GetSavedViewById(c, id)
 }
 
-// GetTaskById operation middleware
-func (siw *ServerInterfaceWrapper) GetTaskById(c *gin.Context) {
+// UpdateSavedViewById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSavedViewById(c *gin.Context) {
 
 	var err error
 
@@ -302,11 +2934,11 @@ func (siw *ServerInterfaceWrapper) GetTaskById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTaskById(c, id)
+	siw.Handler.UpdateSavedViewById(c, id)
 }
 
-// UpdateTaskById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTaskById(c *gin.Context) {
+// GetSavedViewTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetSavedViewTasks(c *gin.Context) {
 
 	var err error
 
@@ -319,6 +2951,25 @@ func (siw *ServerInterfaceWrapper) UpdateTaskById(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSavedViewTasksParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -326,7 +2977,7 @@ func (siw *ServerInterfaceWrapper) UpdateTaskById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateTaskById(c, id)
+	siw.Handler.GetSavedViewTasks(c, id, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -359,35 +3010,223 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/csrf", wrapper.GetCsrfToken)
 	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
 	router.POST(options.BaseURL+"/logout", wrapper.PostLogout)
+	router.POST(options.BaseURL+"/password/forgot", wrapper.PostPasswordForgot)
+	router.POST(options.BaseURL+"/password/reset", wrapper.PostPasswordReset)
+	router.GET(options.BaseURL+"/projects", wrapper.GetAllProjects)
+	router.POST(options.BaseURL+"/projects", wrapper.CreateProject)
+	router.DELETE(options.BaseURL+"/projects/:id", wrapper.DeleteProjectById)
+	router.GET(options.BaseURL+"/projects/:id", wrapper.GetProjectById)
+	router.PATCH(options.BaseURL+"/projects/:id", wrapper.UpdateProjectById)
+	router.POST(options.BaseURL+"/projects/:id/archive", wrapper.ArchiveProject)
+	router.POST(options.BaseURL+"/projects/:id/unarchive", wrapper.UnarchiveProject)
+	router.GET(options.BaseURL+"/reports/estimates", wrapper.GetEstimateReport)
+	router.GET(options.BaseURL+"/sessions", wrapper.GetSessions)
+	router.DELETE(options.BaseURL+"/sessions/:id", wrapper.DeleteSessionById)
 	router.POST(options.BaseURL+"/signup", wrapper.PostSignUp)
+	router.GET(options.BaseURL+"/tags", wrapper.GetAllTags)
+	router.POST(options.BaseURL+"/tags", wrapper.CreateTag)
+	router.DELETE(options.BaseURL+"/tags/:id", wrapper.DeleteTagById)
+	router.GET(options.BaseURL+"/tags/:id", wrapper.GetTagById)
+	router.PATCH(options.BaseURL+"/tags/:id", wrapper.UpdateTagById)
 	router.GET(options.BaseURL+"/tasks", wrapper.GetAllTasks)
 	router.POST(options.BaseURL+"/tasks", wrapper.CreateTask)
+	router.GET(options.BaseURL+"/tasks/search", wrapper.SearchTasks)
 	router.DELETE(options.BaseURL+"/tasks/:id", wrapper.DeleteTaskById)
 	router.GET(options.BaseURL+"/tasks/:id", wrapper.GetTaskById)
 	router.PATCH(options.BaseURL+"/tasks/:id", wrapper.UpdateTaskById)
+	router.GET(options.BaseURL+"/tasks/:id/attachments", wrapper.GetTaskAttachments)
+	router.POST(options.BaseURL+"/tasks/:id/attachments", wrapper.UploadAttachment)
+	router.DELETE(options.BaseURL+"/tasks/:id/attachments/:attachmentId", wrapper.DeleteAttachment)
+	router.GET(options.BaseURL+"/tasks/:id/attachments/:attachmentId/content", wrapper.DownloadAttachment)
+	router.POST(options.BaseURL+"/tasks/:id/checklist", wrapper.CreateChecklistItem)
+	router.PUT(options.BaseURL+"/tasks/:id/checklist/order", wrapper.ReorderChecklistItems)
+	router.DELETE(options.BaseURL+"/tasks/:id/checklist/:itemId", wrapper.DeleteChecklistItem)
+	router.PATCH(options.BaseURL+"/tasks/:id/checklist/:itemId", wrapper.UpdateChecklistItem)
+	router.POST(options.BaseURL+"/tasks/:id/checklist/:itemId/check", wrapper.CheckChecklistItem)
+	router.POST(options.BaseURL+"/tasks/:id/checklist/:itemId/uncheck", wrapper.UncheckChecklistItem)
+	router.GET(options.BaseURL+"/tasks/:id/comments", wrapper.GetTaskComments)
+	router.POST(options.BaseURL+"/tasks/:id/comments", wrapper.CreateComment)
+	router.DELETE(options.BaseURL+"/tasks/:id/comments/:commentId", wrapper.DeleteComment)
+	router.PATCH(options.BaseURL+"/tasks/:id/comments/:commentId", wrapper.UpdateComment)
+	router.POST(options.BaseURL+"/tasks/:id/dependencies", wrapper.CreateTaskDependency)
+	router.DELETE(options.BaseURL+"/tasks/:id/dependencies/:blockerId", wrapper.DeleteTaskDependency)
+	router.GET(options.BaseURL+"/tasks/:id/history", wrapper.GetTaskHistory)
+	router.POST(options.BaseURL+"/tasks/:id/move", wrapper.MoveTask)
+	router.PUT(options.BaseURL+"/tasks/:id/project", wrapper.MoveTaskToProject)
+	router.POST(options.BaseURL+"/tasks/:id/snooze", wrapper.SnoozeTask)
+	router.GET(options.BaseURL+"/tasks/:id/time-entries", wrapper.GetTaskTimeEntries)
+	router.POST(options.BaseURL+"/tasks/:id/time-entries", wrapper.CreateTimeEntry)
+	router.DELETE(options.BaseURL+"/tasks/:id/time-entries/:entryId", wrapper.DeleteTimeEntry)
+	router.PATCH(options.BaseURL+"/tasks/:id/time-entries/:entryId", wrapper.UpdateTimeEntry)
+	router.POST(options.BaseURL+"/tasks/:id/timer", wrapper.StartTimer)
+	router.GET(options.BaseURL+"/timer", wrapper.GetTimer)
+	router.POST(options.BaseURL+"/timer/stop", wrapper.StopTimer)
+	router.POST(options.BaseURL+"/token/refresh", wrapper.PostTokenRefresh)
+	router.GET(options.BaseURL+"/trash", wrapper.GetTrash)
+	router.DELETE(options.BaseURL+"/trash/:id", wrapper.DeleteTrashedTask)
+	router.POST(options.BaseURL+"/trash/:id/restore", wrapper.RestoreTask)
+	router.POST(options.BaseURL+"/verify-email", wrapper.PostVerifyEmail)
+	router.POST(options.BaseURL+"/verify-email/resend", wrapper.PostVerifyEmailResend)
+	router.GET(options.BaseURL+"/views", wrapper.GetSavedViews)
+	router.POST(options.BaseURL+"/views", wrapper.CreateSavedView)
+	router.GET(options.BaseURL+"/views/overdue", wrapper.GetOverdueView)
+	router.GET(options.BaseURL+"/views/someday", wrapper.GetSomedayView)
+	router.GET(options.BaseURL+"/views/today", wrapper.GetTodayView)
+	router.GET(options.BaseURL+"/views/upcoming", wrapper.GetUpcomingView)
+	router.DELETE(options.BaseURL+"/views/:id", wrapper.DeleteSavedViewById)
+	router.GET(options.BaseURL+"/views/:id", wrapper.GetSavedViewById)
+	router.PATCH(options.BaseURL+"/views/:id", wrapper.UpdateSavedViewById)
+	router.GET(options.BaseURL+"/views/:id/tasks", wrapper.GetSavedViewTasks)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYTW/jNhD9KwLboxA53e1Ft3y0hYGiCOKkl4VRcMWxwo1EcjmUF0ag/16QlGRbom0h",
-	"sJ0UzU22SM6bN28+qBeSyVJJAcIgSV8IZk9QUvd4pfjfoJFLYX8xWNCqMCQly0sSE7NSQFKCRnORkzom",
-	"NxqogQeKz/fwvQI015Kt7EalpQJtOKA/hrKCC7DPP2tYkJT8lKwhJI395LZdV8fkmQu2DcFQfA6BELR0",
-	"Jw9eoKGmwkNGZ35VXcdEw/eKa2Ak/eJP7c6Yd3bl12+QGec86sWDfAYRNN69vQdUUiAMaaFbXO/DuBGV",
-	"OiaMGnpoxxpc37ENq81RIeduN0K2kLqkNgKMGghF4DetpR76l0m2GRguDOSg7YYSEGkeiloPbLsw9oeF",
-	"kDrju0mGFts+trwDfeN+a8jmnzLnYq/kh/KtEHSIPPf/AXyPdk0fntsYQjfjuXhU7x7eW6RFEOm4jJh1",
-	"1WQbLmdhjbdlCURVWitGMkliwsWdlrkGRGtMCqttqrMnvrRPCgSzxM/jA4nhTg/BtMX4OBV4l2NvXZmd",
-	"fQcvHlGlfXN6C625SLxOa3Yrng41N1DiOPgdOKo1Xb3SnUfF/r9jwmNTQHu90U1O7B/bV0d0WCgpL4Lo",
-	"x2fprgqvKOIPqdnhbtxkngcz9NUu52Ih3UHcFPbdg2QyurqbkpgsW8mSy4vJxcSalgoEVZyk5NPF5OKT",
-	"LYDUPDmCkgz1wj7k4Ciy5FHDpZgykpI/wKwnHAvSp4rb+ctk4qcPYUAYny+q4JnbnXxDnzU+rqPHqC4Z",
-	"nZcMMNNcGe/OrMoyQFxURaTXy2KCVVlSvfJwo5vZ/e+RaQAbmqNl1Dk5t4uTws4UTigSAx7fSTRu7CA+",
-	"JhtZdBRPByNNvR19oyuow0zvZMO7VMfk8xFDsj3vBcJxTVnUMGRt/3pO21NhQAtaRAh6CTqCbrBci6GN",
-	"YqsBm5e4FoGszEEV2DVjYvGXjG4avwcY/BkhEMhzUan9IPwQdyItDgfYUWK8PDqAUTlv6Yoq9aHznsZm",
-	"DS9hkdk2jfvq+1VRuDHolOV9e84aW9rfIde2wdCiiEzDWMu4/z23XT6YyusPOCdK5fAXojOn89YdIMCw",
-	"fR81E1mEXciL1UdO93TmwxnRSMCPqJ20e1rrsjt54az2TakAA0P13br/LfvXqylz05+mJRhbJdIvL8TO",
-	"Q24ibG96qb/1bSsn3iCgPwfX84GsPg/bpIu/BzmM/7uLgWfNsR99XUXT22C+7yqrZ2Z7crYk/i+V6gOx",
-	"U9RkT8Pora/Rpw3g8btA+APA+BvG+bpA5ZB+dIG9Evbh3K9it8Md4cVZ6YKkJKGKJ8tLUs/rfwMAAP//",
-	"4y/EjoUaAAA=",
+	"H4sIAAAAAAAC/+x9aZPbtpboX0HxTtUkVezFTnzv3J66Hzp2+9r1vOS15dzKxH7PaPFIwjQJKAAoWXH6",
+	"v09hI0ESXNRubR5VPqQtguABzr7g4Es0ZtmcUaBSRBdfIjGeQYb1n5dz8gtwQRhV/0pggvNURhfR4lEU",
+	"R3I1h+giEpITOo3u4uhSSjyeZUClGj3nbA5cEtAzjRmVQOVIv6OmEmNO5lLPHL1++foKqelQAhLGEhI0",
+	"4SxDcgbIvojYRP9zQlIIfXvMAUtILvWnJ4xnWMGZYAknkmTBV9RUb3Cm4Wk8JIn3M6ESpsDV77eEJtW9",
+	"wOWqAx8R5I/Agt+RP8BfESIU3awkiCgugSdU/vXHKA4AIbG4fRkE8C6OOPyeEw5JdPGbgVYvpnjJW3dc",
+	"wYqF1d/Kj8XH2c1/w1hWkXwNYs6ogCaycYVs/o3DJLqI/nJWktmZpbEzj8DuYoUu3PtGud311XqftXN1",
+	"L0BsbgVEQibWWUoBKOYcr+65tKczGN+mRMiXErIAD6rH4NPNDWMpYLoewY8rXwnQ/JwJIu3WNSeU8FkG",
+	"WK6LctUbcQG+94HeTdgNjVbx8AC43DWl1hb0MMSaAubPCaRJUz5eIiWt0EQ9RcsZE4AWOM0BEYE4ZGwB",
+	"CWIU5XMl4KM4Appn6sv+LAqmcc450LEakgBOUkL1aCFJZl4UEnNZkXQlGT9lKeMKNviMs3mqHv7lP87V",
+	"f4oEsZTAFaz/7y+/nZ/8/fLkOT6ZfPzy17t/i4JzZWG9eMOSVXP9djhaciIlUKUeXmN+m7AlDc2uJnkh",
+	"s7Q50U8sWSEONAHulKqbCEmGBKZEkj8gQS9Gr189kF6FhBRvVKH51wyoVep2fVigFAuJzDuniOZpisgE",
+	"EYlmWCAKC+DoBoDaEVEcBkK9h28UjiTPIf4afW5hC62sXe/GUS6AvwyRci5njDtl35h8Lc1tv2EQHnl4",
+	"9/HkISDIdgaCHQlGu/x7Sgzz9s6FoVvEw4hBjbia0vo9ByF/sqKhusR76GOncTNCXwGdyll08Sju0b/6",
+	"nQ54HRV1QOpEW4Y/F589Pz8/j7sBWYsna1DrT7ZD/TNn6odOqMdO6neTgBoUBHVuvhHCA7XORnVD4rXw",
+	"oudoX+E7vIDkFwLLzjVOSCqhd5EjLG6fm5HBlQr3rY2uNXbQti96hKdrsozE04FAP3lY/Kg9fQZzoAnQ",
+	"8aqbfVI2vg3rlJfPnD7RZpKcYYmyXEh0AyhhVPmUXAxQMuUnuiHuhLMwrHrI6ZkbdxdXl9P3WjlUvZnD",
+	"iGT9H7PD7jxrr+eVKzcuSOxqo7tIpvFgzgnjRK76PvuzG3cXO9nRjXI7qET/DaSMTgWSLBgs8KzgHlCu",
+	"y5F3pWnc89I7O8y8IXMx4AU1SptT05eJCK1VlPQ9FchEWCBRFqtb9Sl6mxGpfrkFmOufDfBSvxPFpS5v",
+	"bklVbceRJBn8F+sn4ZEb1yKp7AZ0MBPJ4IpK3s35QJP1LG7KZJgINQ7Xmau2rvL9uIAquDrBJyN2CzQI",
+	"RfF0R0ZnAdz9LLRnnoCrEuozLIswnhODp+hfRM5YLpEVVSWjEqF+Q1giTFdIIUA5sXKmfjfuaAVFIUw/",
+	"qwrOWhi18OxMwMRTY4+N4dWcrxSn1bk05GyCErxC3714cfH69ffKC3WcgpbOlfNWdoquzeaKYjcQpknx",
+	"0il6rxx2Qqfl82VtszJ8C0K5f5ginKYn6vu+61644o/+dnFec8S/++380UfljH/88/Fv5yc/fPz+4rfz",
+	"kyfmp6BbfsU5401qHLMEwpIjAyHwFPrjV25gbCYL0ZX+eDtLgIOtU2npQfWPm1eD3/SUYRXh7kmCYDJh",
+	"XCpsZ4TmEgRiHAnJ+ArNGaFSdMhep2wr0ZnqunJK5FBd/F6NvYsjHfyxLgzJVKznvNe4Me/E5ntde3EN",
+	"c8YDoRkVLWnIzWiQvwLVqQPvcLYUgz3OKqTXbBlUYmwAsOEog16pnsEC1r9duxHlNZTdT543d7MpAFbj",
+	"VMujdzBmNGSjvMuzIqajBmshJ3yzfGAux5HKiEmcdn7HjRSKNTVVt+WGnrKcBsJvb/LsBkwoSsGnglHz",
+	"FExULTARxyrcPmQHtCpL2XRqwrJr7oATCDhN306ii9/WEQ0fa75EpH5ubJiNK04Ytyt3WgdT5AWDq0HE",
+	"SkyvCfUS4FbbvgE9zKhSWxYKNbDcErQEDuXOI0L71X6NyosoYAmC3UQf+3XKiptE3cBxF7e8t1hyoXar",
+	"G3Q2RimFYAhdh/efzjCdhgTFxAYhqrv3iw7064eGvfTrFoWF2WFSA0QgyOZyFULeDUwYh7b5zdPeDyxx",
+	"xxcmLnnRjS8zrAAotgsPbfYrNiV0zViGIoeQhtG/98jT9yKgOHPRAt1rtugPB1D4LIfGLJYkTVXMgpPp",
+	"TGo/dqlHmASP5+bdMDlDFMh0dsNyrlxdPcabzniGQJMy0p7mGQ0KnDmHxf1AxDfuqyWIwU+s4wrrXHzA",
+	"82pzJR0aRmxIPHNQTCEBIQnF6ncXX7D8oNYpGvtM6A373J52aTPJSlhC6/oZC7FkPHnO+JR1LwoyTNJa",
+	"pO7xkx/XC9WZSboguQYBPbtrRwYcqBkgCktUjKj4Y397XIH1P0JRe+dPr7Mm81JcwhVcnheaKuUIZdRP",
+	"pdp/pkyFdzNISJ5FcTQj05nSNnwKNJw1tTQZEPd8PCOLtvKD9cLuw7N5Gw7H+2k66mpqFJBxud4wCjRY",
+	"zyAFCa+tu1lC7fjLIcP9e4zFGFfcycbG78Ywd1i/n0Vu3951Yq9YxIMk9q4rcdeqcLh+/hQ9efLjE3R9",
+	"/f7VFRL5jQCJvnt+ffV///Hs8uWrX//819XV/3n165+v374ZvXj165+/Xl1ev/o1Ri/fjK6uf7l8FaOf",
+	"fn12+av6nx7i/x2jp2/fvxnF6P2b0ctX358inXbHyASCVfTFxWysEmM6aRBrya7UN2JjB7oaZVPL2mhG",
+	"RPrBnWSB6RiSSmBGL8KA/58ayH+8fhtiv2tgPAFer3PpELYKicGA8dUC+AoVeVCkBqKaNifUrm+J9HfX",
+	"iRDX8O/gCGG9SMA1wb9fieLaibrhsnHjCbyAdLQr6qsy9PKYuxBnJRbvx/vF+7sWad5CHkSovQOl1kau",
+	"oKASYO7N678D4Zb71ZxhA47BGiM5s64jN9JEe3EZTky02QTchYUlDtgiCSxISGorbwnhqVeNbEYaT8HG",
+	"P0iwQgs+zwkHsc4K1+Dj+lrKSVIs5HvxFRkgn4XtvpSbXy078r7lLzhMRhrinXOH3bgH4g0ype/ne+u9",
+	"O/B2IU+DkA7cVcrYH/1hh5xKEgifviCJFyUoDhXof2lTQb9nRILiB+v1ihlbel4vnmJCXQhgiVf3rEKs",
+	"Y0qDHFwz4/KtNlKaKUdDOToEop7ooCaHFLQlFiOmhN+SCEBYjD0PwvxLvRF0Ht6Vyf6mG+vMxRlJEqDt",
+	"u0gkGmOqoiU2b6xSncXWtm1aCBYbO6mZfy0C0Vkq94y0tFbseO94cU/JEhbFEaE/czblIITaVuMrW48v",
+	"iqM50EStJrTVIzwdvrZtli41rLXQpuhaq12IkBFuQjxMgozwdNeKRgP/IEpGCcK2WrHkp1VPMY1KQDSr",
+	"xYpYOBGGp6tcvF4tTeGFPdThgthVf/Zmtew44eegorgzZxxHRSqms2Bdb8sS11zmU3R5I4Aq+ZeCENV6",
+	"CLWzjCMXBrIZjRvQ5Q+MDpeHFkLC6M/Ax0ClLUCoglo+01thzsrUfGJRAFwt39Cl9qwcbGKVZtOc09ex",
+	"hferALzPputfORazrp0ntBw3eJO/oiKx/Gf4DIY3/quOYiQ5tGnoMiIjVE6TUCEx1SUc70dPi71SpkK9",
+	"pkYM36GtVF6qAHOqDK0hQY8XxeC1/KWDqOWs5yNr1D00AxNHHNPbgLiwR+gqMTLlHhOqY3wm/2SzaKdo",
+	"pFUH5mCCZ5Cgm5XOYmMdUFTfEIr4zJ6J0O7euw5VOwDJ+7CBv459ur4pupUaWPF1ZsR9ylf7qzt00QAS",
+	"5rEikgmhROgiXJIBAio5UYUVl4jnlOqgMsmAKzRQpiTPOM0TSEIVIN3KZMm4KWoYqhx0rXupIkrDvJB7",
+	"5jyZOUu2xEMlXn8ssxALBdotPn0rKPaMM8uMNYtmUBmGYsCrRfhova5fGE5FflFGyNpaPxi3nug1y1jz",
+	"eJ35pV8lXC3cefaOM3k6kLecMTQHrlYIiSkZMHvylWfz9MtxgZW+SHcVaM/RtK+pqeeJ/ctaTZGCR0jG",
+	"K9lF38W0c+7e73HoDhCaSjU9zblggTCH+d1UbLms1BwXVTrWwNdHR+emzHa92MtwZ+t5kYKpQmh+1/XN",
+	"WiUqGYlLlXOKRq6ISCAl01BKbk0o6vccVCkt5jgDCVxL139ejdCZq5kLn695bstRh1vY5u8RW+utHP6l",
+	"rYBneBVQC29purJ+ZJKDMxi0Ys1UUXuCVyI2jqPWCUzZmtZkURzy70YBoz+096SzE2ih8iSIg2DpApSk",
+	"BjyemWFyBitjdcyBqvPBb5hEeIGJRrUig8rGFU7LD3/9q6dnHoX0jDGgwq0QjPIykceWegXmwnOdar6I",
+	"45VGJFlDUvv2ZJ17KvZlc3nCljT3cacCUSuE0nhZA0A/rNYEse18j0dEOhgxwwtQjom1RQXc5wiPTUl1",
+	"Qlsmr+7uWtj9he9/1AINODPnGbyf0YTjqTnKblJLgDIs9WElVX4j0JLj+VynhdCH/Pz8h3GG+a3+C7SI",
+	"MOStXL4TEGM8hyQgACqu6VC3JXxEyZ+sTeTtKsAnbr9CTpeE3EDcvPB1FC8K5bpU3Btd+aAa8Fj80Ryn",
+	"Zmwp4KBwhYpguzfdJE/TE0WCSGga0w6RphXuTsSop6fID9+XExknT71OBJqSBVA/kO/Aj+K6baAzbd7p",
+	"mKZd6r1bfK7VZNgHa+FADQV7ro/AzvfQQrJ6qHhzMV9jPUnOdcVoqw9pMg9FNb6aRclB61SeonO0nJEU",
+	"iiME2nu07uT6jqN3arIKho2jDPnShlqKyGITN3p482sbg4VOe8YNNFuIO4llRxqkpP1707qLo9Qidpdv",
+	"LkvDFSk5e4reUmvEEC235cw+9U+DFgJdEX5FHwQPNV4Kgs9G7HbFQthV8PHNbe2w8zfeJjcO3yiXpxIO",
+	"8oOYlIVYr3ao4l5406dKD6iFioX3wFqoGKgPtIVKy2oOvl1Ky7oOoiOKA7WnsmecAg7GX3RoRdvR+ixQ",
+	"Jexvzg3pRqJmnDupTHhxVlkfEBYxEgzlwqW/i+lMhnZYArvsZBewXo/9UY79UY79Ufr6o1hhcAD9UQKg",
+	"pwwnfl/cbl0ClY/dEIpDjkHjEGnaIkVtoWiwovr/Yzmog0Fxni785BfgZEJ6EnJ6JMJJwkEInZlb2Ndi",
+	"xDjyvDBjL+fUPR/qgXHAiYodPoBH1lZ265/nazz8ajawXlf7wUO90asr9byTir7ibGDzs3c65D3RaQJJ",
+	"pNrvaMQShi5/fhnF0cL5FNGj0/PTcwUmmwPFcxJdRD+cnp/+YLqwzDRkZ2PBJ+qPKWhiUVBr91EJ7eif",
+	"IMtuPAo648voNx+fn3uNwo1DM0/JWL999t/CqEKzx4Nb/hTekl5lvYHBeAxCTPIU8XJYHIk8yxRLanDR",
+	"03fXz5E7Vmmy9b9FepEf1eCzVB3Z1hhiIrDin5mQ+lR3ZJDhofRBVto4MX5XRbs9qh7a6dbdMEu6i6Mf",
+	"HxAl1W43AXT8hBN3YEN9+8k2v/2SSuBUFzxwVSUARVudkhgcFh0NKBEiSiJgueylAjVmCC7eMPTUrrsB",
+	"g5kjBISTXWcTfYDah6aGaqCJQBilhN4ai1eAsZfcFMV5ei3TTf2EctthSoQErjJx2te3K3HhD4EzHczW",
+	"B3CUzGfSn8afIIoDW1Q9Ab4hjmk/Zj6IdR4HWvyOxzDX7YsvzZ7q80Wm8KRt+UfuqlK2xQPCJQ0aslQb",
+	"2kfwemQXvUtF7v5p/DJdp0V76TyWHzUkbh7bguhc6AY3Y4h1LA/0sVN7+spZ5ApAg+sFu+2hc91fYMNk",
+	"3uhhMIjKfwxUDFbxIgp9ka52Sc3auCwRqSskFzglSYzMGbQE6bJXDjhZaQzuKf3XZXAb0duz8l021mWa",
+	"uiP12jZzlSY6uKuslUjXoLiM4YWreLh0LQtib+mF0TzBqYDmecm7jw3qebi9bXQGGGrH7SGKlTWpqhzm",
+	"JWocgoufPprrMwJorXSw3pDMaO2SPUhmPHporHdtuB1SNCfYF3G0l5Rn8OpUYBldb1KfL2HOvpDkzkiA",
+	"FCQ0SdJ0L7GY+Gn1MmkRNso59GRNEtVpyRc3zcRlXRHpyq1aYyLRiP55jYpiZPumlC9lldMkSLKpMVoL",
+	"s6DcpZC8zFgCUTwQhc1eLyGZGdK4di1m/4803knjZnsL/N+s0MtnbRK2TW9umpS3oCm/FUU5CI9zVWrX",
+	"xGQlU7lZZD68Dm5Nsw4P7GxbB9v69KN86iJqg9chdN3QwWfuYHlrfMka7qV1+M3JLkdrxXHaOrHtHcIt",
+	"TlREYy2L6yynvfh+74b8L8B4Tg8H5wVehmCd6/7T4qxoU+y59LW6quL05ZSzfG5OXyor1XYa/u796On3",
+	"9X7Dq2arYR21ulmVXdpzSuQpelo0sFaFWcIEw5bDD9rpEIxzxGqH7eKyN4iaw2853TC/rupd02s0XS8A",
+	"UeB4jZbnwAlLqjXWjx7r/RB+v1+vSax5pcXIt13RS/LpbdPcKIDF/RDWIHJJegV1C1ySrQXVJtm4pSf8",
+	"cNvzaCL4bro+T+03ei/cUTyW6lBC2Wre9ruwrG1c4DlwE35WbG4JyMkeK2ys6LEx63aJ85ZCEdhW0xbN",
+	"zWzLsxhlTEjEYQxUpiauWtw61WBs1+5rkynfRkuxA3eALNbJokCE8FMMHmrd4xpuA+GbUOMIhU03scO4",
+	"SndQhlTZFHDEYcJBmNafWG9gkQ8PxYMsIrboRP8YSvmYldgszP5bDtcaUIT9xoQt+CVTms+7c82m19uG",
+	"osTNPndbDg/XOtl1M7raLpTPj8qmRnDv7L6Es0zmt84Mk2qrtUmBXmnb9Q2kfWzZpdtt/f++dM9IV2Vv",
+	"LtVTqxPfMh/7veQCmzvC02N6Z+30ji3kr1KZY+mBKZ0Rnu5WfSvUt2U99jXzIPG0EdUrmLxNjm53p8+3",
+	"xboHZWh34a0zw7Bx5G0qu3AfsX++TbF/zCgMzyh00K8R++K235SzjWe642264U3ZPEFRtjCtvlN9g4kh",
+	"6lDIqmiPVW7PA/QXEXKli9VVBCy6i9sB9lqvDALXb+y1HsBdHVt6wB3cFWXAAvTJoTD0/ad9hsPpWv90",
+	"F0uUp7bWq/Oob0jCdEGxOc6lQqeq4VD3p12XxMZny+q1xmcvU8Fc/zr/85gDsr0IVbx7OWPCtsjVt/fa",
+	"JqpSReUArUB67V1sY8KbFXLFdHFXGZ5rPLQe3N52Gdj8M+i6+UlxqV554XAIikrDqaFFLuXpxXtB5rcg",
+	"HgDaiD0IYM/rbVuYkrXqW6Le6EddelsuQykBTPQ5d1MErAtjFao5yJxTc+apaJRZdnqx7WqFvmC36PVS",
+	"6x0kUNEFFdqSE/aK56GxUb/xUbgEVJiUy1AVXukc1Tanu2hnIJRlt6wADc3x77lOjwjGi11GiueK7jAu",
+	"KzbnsCAsF67jSwg0M1GIxfx0TujNlGREhitkn5x7PcgeVxonP4pbbLyNGVl+P59jQuYrQzfGPirNK3Fr",
+	"/YPO4I0+Er3J6E316PzWwzdeq7CgIa9ayR8DOGsHcMRtgNYKW/7M6Kr2YgHgmSkWEKAMeml0kJjjsVVr",
+	"pVGZmYsAtNpRp5ck8EzpJSC6GhYrRZfoBmIf6CfdSeFCd034pA9dwGf7KiTmihRh0lY00CjPnJj59CE6",
+	"PT39EH3Sp9KFBeoDVaUJph+DgiIFrG7PQJ9OPiEKU50JVfMq6E6RtY6sJlbrdM2GTz/QD1SrJHHxgSJ0",
+	"gj4Zx+OirFX4ZB9IPL1QbTk/GbisGXnxIXpHEkC29kQB+p1aja4ghhNCBVBBVFLuezuPcxUulMp2kyc5",
+	"XDw+f/zk5PzxyfmjT7H5xfT/835H32Fla34yD/7xKbZ/QvnXPz59bwBUE1BGwX3C8tWFHWZmfaRmVZvw",
+	"nt5S1VfeNr9QM9hTMWaXhbVV1CLBOww1r3UF18R6+qGZ6rMWRdhvLPsZNbYfhTYCOWSgD9HvOeYSeLpC",
+	"JmP9IWpR4L93xlNqDXPiocr9aAUdraCjdqplCo1vVN6cjzBy1NCupYZmGsTtrlMN4jZwwcheYuK1vn3c",
+	"u4fb3XHSNEzbEw5b3fDzrVmbh5VyELeBmG3hVHQnHTaNwM1lHe7hrpxv113Zx8TDj+d/3963R/YuFXtp",
+	"hTYmqjFQFeNMGN1P7ipyIh0MVtVRZ7ho3NSZJlEbc+kNPTT56cH+TZVIlssqb2DwkO49rwRsmqWQE90g",
+	"eDXXUekEpHGNdC08kSbkC1RqX8rdnqeveDOeEk5TtoRETyAa7lK9Rdj2hXeWp5LMMZeqO0x24nq+DpXf",
+	"7R3Othx08oFop6ByFMo17Hsn0n/cskhXUnvCcmo6zzz6YXuff674igiUYj7V2SdszpUI8gcg40ZqkJ5s",
+	"GSTH6mpnLPfuqUpTBIywEVA6fqepG5n2jj0Sr13ZnX0p//FyiK+2YfEVB2fxYdyA9+cJin08Zb9VOeHt",
+	"RUVa7GvBHaae9v96BjjzFhg0Ap+xJd2CGt88H3Shk40lyBMhOeCsitb+jqUNZNomdoWNVKwhNui1kWc7",
+	"7OQZES4AfVFF7Qxworf4SxQYXIWzAdWRh/eRhy0z2at1Cjq5F1NX7pzuSsk+rfX5P5gISgD8HRrhNTja",
+	"KeFp5frpvUwG79gU37+2BYmyNav3hgftzGJIBz+eFdfkzfMAU16Dfl4hJ3FIbBlcwA5jnHVAhnAmB1ep",
+	"dqzR6DygqbfJXhTrc0c48DSIPb6o9wc5X5vXXGG70wC4Ac+rphoO7bRPXUS2YL4nmXNwWN1UbujrLJvz",
+	"nVs2xwMrw5MzA1mnV2ianzpMfvX4sOXm7glb7/EhyGUN98PRVk57qOu9GXCkr68UnPRgKMxi/L40Zu7f",
+	"680xP3XjDi3B7AD/lrLLDmnqlJKx8GPE0gSELJodFai3Q3uPBTwtLmI8tOhT88LKbcedHAQd4sUMOYaa",
+	"DifUZDEWjDGVTNUiTs++2L+GedEb5L2wni6g24QLbXfuAH1nA7k7U6lyQ7mcMa47j5nlINIuXTtd6oNC",
+	"8cb86XvK6vNdyOqj8zyAd64SIvs4B9QYIgcJ0ATmQBOgY3ulYN9Jxmdu/OrwLJcq/Ht8ZLIEck8NmC1W",
+	"Int7sWR5mtgdQdh0Qd3PAxqY31orBuFKETWmTB+prBk4FS7s5NGzL2Y2/nLouZoNc2xYGRZAbsDe8Sji",
+	"YEyea3ObOEZJCbyuKcbrkcKMCMn4qi968MIO28pFPMfTiOtL/6vFPWMkR2MoFJ+ZYToFZJmjzMMiQtF4",
+	"xhll6i7bMU6RO4TbcxhEMWu7NaTO39muDgdjATmY9/3klTmCeTx3dZjnrl4bJWfuiiByRky/qTFnQrh+",
+	"cWOW5hkV/Uzoenq1lQ05kh6xjV9/sjl+LIA/MubBqyOf+iUrbH1Lx7q/GR7fVu4k7OcC02uuXRmZPiiH",
+	"po5KqPed7l2vvyPld/WG0JvkaN9c9YPRJJc5N/eU6LuBlvgWEJHVdvMtZK9eOgEqOYHeXOmIZHBlhx5c",
+	"P4MS9m8pY6rQhyz6Snu8NWtawXZvQzW7ZYcYgXSg7zL4WMLQIfoc/lbHFOoBpFBfsalhOTHXB2hcmQLK",
+	"MM2xQlgrr3XI3bMv6o/VsEjjRpkyHGS00G2iG1BJ/u3nQbdJgiU8h3Ems1QAq04x39ni5qBIamPtcu6t",
+	"Ms53pjL2s33OkV9b08klt56idyCl7mhNE0gurTLhOTVtrvWahGRzUdh5fC3lwjtcWYm5HNkZt2HG78Co",
+	"4qZX/NGeqjQi2WaI9dKlYDU2iEA45YCTlaPx/XSwFdFYRuWlfWfrPxi1nKjLP3hOEXaDe3jTMWSre225",
+	"caOqhX9Lnq+TlEMkoxpypoRpl0xk860gYbD0YvP5/+YMzRtWSo79lhhsvjZBslugZ/aiV58mq5+/+mwS",
+	"rsYEsMPNZbBozNgtATRh3PYx96+KNW2L9M+Vt07RlepkVJsJU9VcTV8pzIyUG8N/olyoxSiJp3uN26td",
+	"hW7OVl6a2rwLdaQmvbZLC/NSjd7VC8IBFST5R1tUmo2dJgJlRKjdiF1X7xjB5znh5mYap9bUBu5pTYxd",
+	"jr7iuHKhcPAuUt3ktktXcdyK2p21kT5Frys3ZLtwgrtAJgMbDN3nJrX2iqdAo2H9bw8/Q/s9q7GQbDZr",
+	"Najls8PHHHiGqcbRXqLi5xI+C7NX4zIcNWcchGS8I594bQZsETVbTujZHTiIokENqUO0rhfsQ/UCOJms",
+	"TiDDJG3X4KpA1WhvPRDhJOEgBMIC6QmIfxuEUTfF180AsysoJfT2FP2iP6r0MqaF3ikmcpPr/YZEBNWz",
+	"meJKg72ZcJr3hbVDaQHpoScqF7kv9rBOtJZYI6K48YNxZx3sJbEb9DRpssUa8OlcCTWgSTu5vwOaiDDx",
+	"WivS1mSkbDqFRIlU9a1TdGnb+cICKFrOgNrrUVKi6ncZRzktyXw8ZjmVQgsYTsZSIBNosJgRMbrJZWnK",
+	"KpWDDORIiUe+wGkfa1ybhTbo83Fzzb/4KzU7KhSmt02bowKhXqjFbZqG5vE2gz9VCjCALbHQe1NYafuq",
+	"DRSpNKgYrMwMcgmBZWf5xDu8gOQXPWqDern8yrcU6RFqVUhvsWttqfbeQ4V+1lvPUOzORm+JK76yw9ID",
+	"D4YOMii29Xhr3KBAj74gWN+2llhD0dx4jIW6Tc3QVZ0iC+FwxhbAkxza75FrubDVXdXKErwqL8JNclv7",
+	"M8MCzbEQkJyiZzpaQxOE+XhGFhZMYe6Ca79alzJZJEtWIGP9HD7bS97QM7wy95dptWu0tuPBfxcGij8Y",
+	"hYZO/SfIt2bRluk677t+efnmspzMhKMIFRJw4nO9/0VlTSQwJgmgpVqX2SMi2u5wJRn8lwF0eLhWv7B/",
+	"t2adIkMvSW7jGrUQx5Fza4rEsl/jbtEmowqWQYJXPYxa3hlOWcGv+8iC78xyjix4ZMG9iHCqcAfLJcIF",
+	"13RyoyaotZWmIUPFcwF1qZjNqExzb/z+8eyIHTn2yLH7wrGaeTQbdjFqPh+zTCVp1+VVe35N0aq+nx/R",
+	"PLsBrug30Tw0kcANAPvIq+/tuoew65vWlSmutPcqtzCieiN85Ppv3onrv/cduI6PIuQoQnYiQixPqV4F",
+	"yBJzuzQZlt4s4hy7vdPWi6QcWm+wMrbWuL+xDKr1BjUP8obbYUGyY3eIQWw+iI6KUwj1XIHR+zacxmGe",
+	"4rEtejLz+oG25YylELjxMcFbkwebOoJw/9Dx+c5Cx8dmcsM7sQ9gkqoGNDX97RZ1nUGIQHqlpQUrcAZo",
+	"qXxhw0/aNEMlXygT759XI3Tm4mLtQn5kRxw7He1lp6Njk6OHOlKtLdZM6SpdaeOxbYhh1Rx6UsMOOU+j",
+	"i+gMz8nZ4lF09/HufwYA6mPA1+8dAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -5,6 +5,7 @@ import (
 	"backend/adapter/controller/middleware"
 	"backend/adapter/controller/presenter"
 	"backend/adapter/gateway"
+	"backend/entity"
 	"backend/pkg"
 	"backend/pkg/logger"
 	"backend/usecase"
@@ -39,7 +40,10 @@ func setupSwagger(router *gin.Engine) (*openapi3.T, error) {
 	return swagger, nil
 }
 
-func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
+// multipartOverhead は添付ファイル以外に multipart のボディに含まれる境界やヘッダーの分の余裕
+const multipartOverhead = 1 << 20
+
+func NewGinRouter(db *gorm.DB, corsAllowOrigins []string, blobStorage gateway.IBlobStorage, attachmentPolicy *entity.AttachmentPolicy, mailer gateway.IMailer, appURL string, verificationPolicy *entity.EmailVerificationPolicy) (*gin.Engine, error) {
 	router := gin.Default()
 
 	router.Use(middleware.CorsMiddleware(corsAllowOrigins))
@@ -62,17 +66,80 @@ func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
 			csrfHandler := handler.NewCsrfHandler()
 
 			userRepository := gateway.NewUserRepository(db)
-			userUseCase := usecase.NewUserUsecase(userRepository)
+			sessionRepository := gateway.NewSessionRepository(db)
+			tokenRevocationStore := gateway.NewTokenRevocationRepository(db)
+			userUseCase := usecase.NewUserUsecase(userRepository, sessionRepository, tokenRevocationStore, mailer, appURL)
 			userHandler := handler.NewUserHandler(userUseCase)
 
+			emailVerificationUseCase := usecase.NewEmailVerificationUsecase(userRepository, mailer, appURL, verificationPolicy)
+			emailVerificationHandler := handler.NewEmailVerificationHandler(emailVerificationUseCase)
+
+			passwordResetRepository := gateway.NewPasswordResetRepository(db)
+			passwordUseCase := usecase.NewPasswordUsecase(userRepository, passwordResetRepository, sessionRepository, mailer, appURL)
+			passwordHandler := handler.NewPasswordHandler(passwordUseCase)
+
+			sessionUseCase := usecase.NewSessionUsecase(sessionRepository)
+			sessionHandler := handler.NewSessionHandler(sessionUseCase)
+
 			taskRepository := gateway.NewTaskRepository(db)
-			taskUseCase := usecase.NewTaskUsecase(taskRepository)
+			taskEventRepository := gateway.NewTaskEventRepository(db)
+			attachmentRepository := gateway.NewAttachmentRepository(db)
+			timeEntryRepository := gateway.NewTimeEntryRepository(db)
+			taskUseCase := usecase.NewTaskUsecase(taskRepository, taskEventRepository, attachmentRepository, blobStorage, timeEntryRepository)
 			taskHandler := handler.NewTaskHandler(taskUseCase)
+			trashHandler := handler.NewTrashHandler(taskUseCase)
+
+			tagRepository := gateway.NewTagRepository(db)
+			tagUseCase := usecase.NewTagUsecase(tagRepository)
+			tagHandler := handler.NewTagHandler(tagUseCase)
+
+			projectRepository := gateway.NewProjectRepository(db)
+			projectUseCase := usecase.NewProjectUsecase(projectRepository)
+			projectHandler := handler.NewProjectHandler(projectUseCase)
+
+			checklistItemRepository := gateway.NewChecklistItemRepository(db)
+			checklistUseCase := usecase.NewChecklistUsecase(checklistItemRepository)
+			checklistHandler := handler.NewChecklistHandler(checklistUseCase)
+
+			commentRepository := gateway.NewCommentRepository(db)
+			commentUseCase := usecase.NewCommentUsecase(commentRepository)
+			commentHandler := handler.NewCommentHandler(commentUseCase)
+
+			attachmentUseCase := usecase.NewAttachmentUsecase(attachmentRepository, blobStorage, attachmentPolicy)
+			attachmentHandler := handler.NewAttachmentHandler(attachmentUseCase)
+
+			timeEntryUseCase := usecase.NewTimeEntryUsecase(timeEntryRepository)
+			timeEntryHandler := handler.NewTimeEntryHandler(timeEntryUseCase)
+
+			reportRepository := gateway.NewReportRepository(db)
+			reportUseCase := usecase.NewReportUsecase(reportRepository)
+			reportHandler := handler.NewReportHandler(reportUseCase)
+
+			savedViewRepository := gateway.NewSavedViewRepository(db)
+			viewUseCase := usecase.NewViewUsecase(taskRepository, userRepository, savedViewRepository)
+			viewHandler := handler.NewViewHandler(viewUseCase)
+
+			taskDependencyRepository := gateway.NewTaskDependencyRepository(db)
+			dependencyUseCase := usecase.NewDependencyUsecase(taskDependencyRepository, taskRepository)
+			dependencyHandler := handler.NewDependencyHandler(dependencyUseCase)
 
 			serverHandler := handler.NewHandler().
 			Register(csrfHandler).
 			Register(userHandler).
-			Register(taskHandler)
+			Register(sessionHandler).
+			Register(passwordHandler).
+			Register(emailVerificationHandler).
+			Register(taskHandler).
+			Register(trashHandler).
+			Register(tagHandler).
+			Register(projectHandler).
+			Register(checklistHandler).
+			Register(commentHandler).
+			Register(attachmentHandler).
+			Register(timeEntryHandler).
+			Register(reportHandler).
+			Register(viewHandler).
+			Register(dependencyHandler)
 
 			wrapper := presenter.ServerInterfaceWrapper{
 				Handler: serverHandler,
@@ -81,6 +148,15 @@ func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
 			csrfTokenGenerator := middleware.CsrfTokenGenerator()
 			v1.GET("/csrf", csrfTokenGenerator, wrapper.GetCsrfToken)
 
+			// アップロードは OAPI バリデータがボディを読み込む前にサイズを制限するため、ミドルウェアを個別に並べる
+			v1.POST("/tasks/:id/attachments",
+				middleware.BodyLimitMiddleware(attachmentPolicy.MaxSize+multipartOverhead),
+				middleware.CsrfValidator(),
+				ginMiddleware.OapiRequestValidator(swagger),
+				middleware.JwtAuthMiddleware(tokenRevocationStore, sessionRepository),
+				middleware.EmailVerificationMiddleware(verificationPolicy, userRepository),
+				wrapper.UploadAttachment)
+
 			useCsrf := v1.Group("")
 			{
 
@@ -91,18 +167,90 @@ func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
 				useCsrf.POST("/signup", wrapper.PostSignUp)
 				useCsrf.POST("/login", wrapper.PostLogin)
 				useCsrf.POST("/logout", wrapper.PostLogout)
+				useCsrf.POST("/token/refresh", wrapper.PostTokenRefresh)
+				useCsrf.POST("/password/forgot", wrapper.PostPasswordForgot)
+				useCsrf.POST("/password/reset", wrapper.PostPasswordReset)
+				useCsrf.POST("/verify-email", wrapper.PostVerifyEmail)
+				// 確認メールの再送は未確認のユーザーが使うので、未確認のユーザーへの制限をかけない
+				useCsrf.POST("/verify-email/resend", middleware.JwtAuthMiddleware(tokenRevocationStore, sessionRepository), wrapper.PostVerifyEmailResend)
 
 				useJwt := useCsrf.Group("")
 				{
 					// useJwtではCSRF検証->OAPIバリデータ->JWT認証
 					// 処理が軽いものからすることで負荷を軽減
-					useJwt.Use(middleware.JwtAuthMiddleware())
+					useJwt.Use(middleware.JwtAuthMiddleware(tokenRevocationStore, sessionRepository))
+					useJwt.Use(middleware.EmailVerificationMiddleware(verificationPolicy, userRepository))
 
 					useJwt.POST("/tasks", wrapper.CreateTask)
 					useJwt.GET("/tasks/:id", wrapper.GetTaskById)
 					useJwt.GET("/tasks", wrapper.GetAllTasks)
+					useJwt.GET("/tasks/search", wrapper.SearchTasks)
 					useJwt.PATCH("/tasks/:id", wrapper.UpdateTaskById)
 					useJwt.DELETE("/tasks/:id", wrapper.DeleteTaskById)
+					useJwt.POST("/tasks/:id/move", wrapper.MoveTask)
+					useJwt.PUT("/tasks/:id/project", wrapper.MoveTaskToProject)
+					useJwt.POST("/tasks/:id/snooze", wrapper.SnoozeTask)
+					useJwt.GET("/tasks/:id/history", wrapper.GetTaskHistory)
+
+					useJwt.POST("/tasks/:id/checklist", wrapper.CreateChecklistItem)
+					useJwt.PUT("/tasks/:id/checklist/order", wrapper.ReorderChecklistItems)
+					useJwt.PATCH("/tasks/:id/checklist/:itemId", wrapper.UpdateChecklistItem)
+					useJwt.DELETE("/tasks/:id/checklist/:itemId", wrapper.DeleteChecklistItem)
+					useJwt.POST("/tasks/:id/checklist/:itemId/check", wrapper.CheckChecklistItem)
+					useJwt.POST("/tasks/:id/checklist/:itemId/uncheck", wrapper.UncheckChecklistItem)
+
+					useJwt.GET("/tasks/:id/comments", wrapper.GetTaskComments)
+					useJwt.POST("/tasks/:id/comments", wrapper.CreateComment)
+					useJwt.PATCH("/tasks/:id/comments/:commentId", wrapper.UpdateComment)
+					useJwt.DELETE("/tasks/:id/comments/:commentId", wrapper.DeleteComment)
+
+					useJwt.GET("/tasks/:id/attachments", wrapper.GetTaskAttachments)
+					useJwt.GET("/tasks/:id/attachments/:attachmentId/content", wrapper.DownloadAttachment)
+					useJwt.DELETE("/tasks/:id/attachments/:attachmentId", wrapper.DeleteAttachment)
+
+					useJwt.GET("/tasks/:id/time-entries", wrapper.GetTaskTimeEntries)
+					useJwt.POST("/tasks/:id/time-entries", wrapper.CreateTimeEntry)
+					useJwt.PATCH("/tasks/:id/time-entries/:entryId", wrapper.UpdateTimeEntry)
+					useJwt.DELETE("/tasks/:id/time-entries/:entryId", wrapper.DeleteTimeEntry)
+					useJwt.POST("/tasks/:id/timer", wrapper.StartTimer)
+					useJwt.GET("/timer", wrapper.GetTimer)
+					useJwt.POST("/timer/stop", wrapper.StopTimer)
+
+					useJwt.POST("/tasks/:id/dependencies", wrapper.CreateTaskDependency)
+					useJwt.DELETE("/tasks/:id/dependencies/:blockerId", wrapper.DeleteTaskDependency)
+
+					useJwt.GET("/reports/estimates", wrapper.GetEstimateReport)
+					useJwt.GET("/views/today", wrapper.GetTodayView)
+					useJwt.GET("/views/upcoming", wrapper.GetUpcomingView)
+					useJwt.GET("/views/overdue", wrapper.GetOverdueView)
+					useJwt.GET("/views/someday", wrapper.GetSomedayView)
+					useJwt.GET("/views", wrapper.GetSavedViews)
+					useJwt.POST("/views", wrapper.CreateSavedView)
+					useJwt.GET("/views/:id", wrapper.GetSavedViewById)
+					useJwt.PATCH("/views/:id", wrapper.UpdateSavedViewById)
+					useJwt.DELETE("/views/:id", wrapper.DeleteSavedViewById)
+					useJwt.GET("/views/:id/tasks", wrapper.GetSavedViewTasks)
+
+					useJwt.GET("/trash", wrapper.GetTrash)
+					useJwt.POST("/trash/:id/restore", wrapper.RestoreTask)
+					useJwt.DELETE("/trash/:id", wrapper.DeleteTrashedTask)
+
+					useJwt.POST("/projects", wrapper.CreateProject)
+					useJwt.GET("/projects/:id", wrapper.GetProjectById)
+					useJwt.GET("/projects", wrapper.GetAllProjects)
+					useJwt.PATCH("/projects/:id", wrapper.UpdateProjectById)
+					useJwt.DELETE("/projects/:id", wrapper.DeleteProjectById)
+					useJwt.POST("/projects/:id/archive", wrapper.ArchiveProject)
+					useJwt.POST("/projects/:id/unarchive", wrapper.UnarchiveProject)
+
+					useJwt.POST("/tags", wrapper.CreateTag)
+					useJwt.GET("/tags/:id", wrapper.GetTagById)
+					useJwt.GET("/tags", wrapper.GetAllTags)
+					useJwt.PATCH("/tags/:id", wrapper.UpdateTagById)
+					useJwt.DELETE("/tags/:id", wrapper.DeleteTagById)
+
+					useJwt.GET("/sessions", wrapper.GetSessions)
+					useJwt.DELETE("/sessions/:id", wrapper.DeleteSessionById)
 				}
 			}
 		}
@@ -0,0 +1,128 @@
+package router_test
+
+import (
+	"backend/adapter/controller/router"
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type RouterSuite struct {
+	tester.DBSQLiteSuite
+	router *gin.Engine
+	ur     gateway.IUserRepository
+	sr     gateway.ISessionRepository
+	tr     gateway.ITaskRepository
+}
+
+func TestRouterSuite(t *testing.T) {
+	suite.Run(t, new(RouterSuite))
+}
+
+func (suite *RouterSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.T().Setenv("SECRET", "secret")
+	suite.ur = gateway.NewUserRepository(suite.DB)
+	suite.sr = gateway.NewSessionRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+
+	blobStorage, err := gateway.NewLocalStorage(suite.T().TempDir())
+	suite.Require().Nil(err)
+	policy := &entity.AttachmentPolicy{MaxSize: 1 << 20, AllowedTypes: []string{"image/png"}}
+	verificationPolicy := &entity.EmailVerificationPolicy{Restriction: entity.UnverifiedReadOnly, ResendInterval: time.Minute}
+	suite.router, err = router.NewGinRouter(suite.DB, []string{"*"}, blobStorage, policy, gateway.NewFileMailer("", "no-reply@test.com"), "http://app.test", verificationPolicy)
+	suite.Require().Nil(err)
+}
+
+// login はユーザーとセッションを作り、そのセッションのアクセストークンを返す
+func (suite *RouterSuite) login(user *entity.User) (*entity.User, string) {
+	user, err := suite.ur.Create(user)
+	suite.Require().Nil(err)
+	now := time.Now().UTC()
+	refreshToken, _, err := entity.NewRefreshToken(0, now)
+	suite.Require().Nil(err)
+	session, err := suite.sr.Create(entity.NewSession(user.ID, "laptop", now), refreshToken)
+	suite.Require().Nil(err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"jti":     user.Email,
+		"user_id": user.ID,
+		"sid":     session.ID,
+		"exp":     now.Add(time.Minute).Unix(),
+	}).SignedString([]byte("secret"))
+	suite.Require().Nil(err)
+	return user, token
+}
+
+// upload はタスクに PNG を添付するリクエストを送る
+func (suite *RouterSuite) upload(taskID entity.TaskID, token string) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "shot.png")
+	suite.Require().Nil(err)
+	_, err = part.Write([]byte("\x89PNG\r\n\x1a\n"))
+	suite.Require().Nil(err)
+	suite.Require().Nil(writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/tasks/%d/attachments", taskID), &body)
+	req.Host = "localhost:8080"
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-CSRF-Token", "csrf")
+	req.AddCookie(&http.Cookie{Name: "_csrf", Value: "csrf"})
+	req.AddCookie(&http.Cookie{Name: "token", Value: token})
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+func (suite *RouterSuite) TestUploadRequiresVerifiedEmail() {
+	// test unverified users cannot upload under the read_only policy
+	unverified, token := suite.login(&entity.User{Email: "unverified@test.com"})
+	task, err := suite.tr.Create(&entity.Task{Name: "design", Status: entity.Status{Name: entity.Todo}, UserID: unverified.ID})
+	suite.Require().Nil(err)
+	w := suite.upload(task.ID, token)
+	suite.Assert().Equal(http.StatusForbidden, w.Code)
+	suite.Assert().Contains(w.Body.String(), entity.ErrEmailNotVerified.Error())
+
+	// test verified users can upload
+	verifiedAt := time.Now()
+	verified, token := suite.login(&entity.User{Email: "verified@test.com", EmailVerifiedAt: &verifiedAt})
+	task, err = suite.tr.Create(&entity.Task{Name: "design", Status: entity.Status{Name: entity.Todo}, UserID: verified.ID})
+	suite.Require().Nil(err)
+	suite.Assert().Equal(http.StatusCreated, suite.upload(task.ID, token).Code)
+}
+
+func (suite *RouterSuite) TestMissingAttachmentIsNotFound() {
+	verifiedAt := time.Now()
+	user, token := suite.login(&entity.User{Email: "missing-attachment@test.com", EmailVerifiedAt: &verifiedAt})
+	task, err := suite.tr.Create(&entity.Task{Name: "design", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Require().Nil(err)
+
+	// test download and delete of a missing attachment are 404
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		path := fmt.Sprintf("/api/v1/tasks/%d/attachments/999", task.ID)
+		if method == http.MethodGet {
+			path += "/content"
+		}
+		req := httptest.NewRequest(method, path, nil)
+		req.Host = "localhost:8080"
+		req.Header.Set("X-CSRF-Token", "csrf")
+		req.AddCookie(&http.Cookie{Name: "_csrf", Value: "csrf"})
+		req.AddCookie(&http.Cookie{Name: "token", Value: token})
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+		suite.Assert().Equal(http.StatusNotFound, w.Code, method)
+		suite.Assert().Contains(w.Body.String(), entity.ErrAttachmentNotFound.Error())
+	}
+}
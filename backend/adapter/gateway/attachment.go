@@ -0,0 +1,85 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type IAttachmentRepository interface {
+	CheckTask(taskID entity.TaskID, userID entity.UserID) error
+	Create(attachment *entity.Attachment) (*entity.Attachment, error)
+	Get(attachmentID entity.AttachmentID, taskID entity.TaskID, userID entity.UserID) (*entity.Attachment, error)
+	GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Attachment, error)
+	Delete(attachmentID entity.AttachmentID) error
+	GetOrphans() (*[]entity.Attachment, error)
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) IAttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+// ownedTask は指定ユーザーが所有するタスクの添付ファイルに絞り込む条件
+func (ar *attachmentRepository) ownedTask(db *gorm.DB, taskID entity.TaskID, userID entity.UserID) *gorm.DB {
+	return db.Where("task_id = ? AND task_id IN (?)", taskID,
+		ar.db.Model(&entity.Task{}).Select("id").Where("id = ? AND user_id = ?", taskID, userID))
+}
+
+// CheckTask は指定ユーザーがタスクを所有しているか確認する
+func (ar *attachmentRepository) CheckTask(taskID entity.TaskID, userID entity.UserID) error {
+	var task entity.Task
+	if err := ar.db.Select("id").Where("id = ? AND user_id = ?", taskID, userID).First(&task).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.ErrTaskNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (ar *attachmentRepository) Create(attachment *entity.Attachment) (*entity.Attachment, error) {
+	if err := ar.CheckTask(attachment.TaskID, attachment.UserID); err != nil {
+		return nil, err
+	}
+	if err := ar.db.Create(attachment).Error; err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (ar *attachmentRepository) Get(attachmentID entity.AttachmentID, taskID entity.TaskID, userID entity.UserID) (*entity.Attachment, error) {
+	var attachment = entity.Attachment{}
+	if err := ar.ownedTask(ar.db, taskID, userID).Where("id = ?", attachmentID).First(&attachment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (ar *attachmentRepository) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Attachment, error) {
+	attachments := []entity.Attachment{}
+	if err := ar.ownedTask(ar.db, taskID, userID).Order("id").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return &attachments, nil
+}
+
+func (ar *attachmentRepository) Delete(attachmentID entity.AttachmentID) error {
+	return ar.db.Delete(&entity.Attachment{}, attachmentID).Error
+}
+
+// GetOrphans は完全に削除されたタスクに残っている添付ファイルを返す。ゴミ箱にあるタスクの添付ファイルは含まない
+func (ar *attachmentRepository) GetOrphans() (*[]entity.Attachment, error) {
+	attachments := []entity.Attachment{}
+	if err := ar.db.Where("task_id NOT IN (SELECT id FROM tasks)").Order("id").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return &attachments, nil
+}
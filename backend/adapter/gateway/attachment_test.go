@@ -0,0 +1,80 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AttachmentRepositorySuite struct {
+	tester.DBSQLiteSuite
+	ar gateway.IAttachmentRepository
+	tr gateway.ITaskRepository
+	ur gateway.IUserRepository
+}
+
+func TestAttachmentRepositorySuite(t *testing.T) {
+	suite.Run(t, new(AttachmentRepositorySuite))
+}
+
+func (suite *AttachmentRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.ar = gateway.NewAttachmentRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *AttachmentRepositorySuite) TestAttachmentRepository() {
+	user, err := suite.ur.Create(&entity.User{Email: "attachment@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-attachment@test.com"})
+	suite.Assert().Nil(err)
+	task, err := suite.tr.Create(&entity.Task{Name: "design", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	kept, err := suite.tr.Create(&entity.Task{Name: "kept", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+
+	// test create
+	mockup, err := suite.ar.Create(&entity.Attachment{TaskID: task.ID, UserID: user.ID, FileName: "mockup.png", ContentType: "image/png", Size: 10, StorageKey: "tasks/1/mockup"})
+	suite.Assert().Nil(err)
+	_, err = suite.ar.Create(&entity.Attachment{TaskID: kept.ID, UserID: user.ID, FileName: "spec.pdf", ContentType: "application/pdf", Size: 20, StorageKey: "tasks/2/spec"})
+	suite.Assert().Nil(err)
+
+	// test another user's task is rejected
+	suite.Assert().Nil(suite.ar.CheckTask(task.ID, user.ID))
+	suite.Assert().ErrorIs(suite.ar.CheckTask(task.ID, other.ID), entity.ErrTaskNotFound)
+	_, err = suite.ar.Create(&entity.Attachment{TaskID: task.ID, UserID: other.ID, FileName: "hijack.png", ContentType: "image/png", Size: 10, StorageKey: "tasks/1/hijack"})
+	suite.Assert().ErrorIs(err, entity.ErrTaskNotFound)
+
+	// test get
+	attachments, err := suite.ar.GetAll(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*attachments, 1)
+	suite.Assert().Equal("mockup.png", (*attachments)[0].FileName)
+	attachments, err = suite.ar.GetAll(task.ID, other.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*attachments)
+	_, err = suite.ar.Get(mockup.ID, task.ID, other.ID)
+	suite.Assert().ErrorIs(err, entity.ErrAttachmentNotFound)
+
+	// test attachments of trashed tasks are kept until the task is deleted permanently
+	suite.Assert().Nil(suite.tr.Delete(task.ID, user.ID))
+	orphans, err := suite.ar.GetOrphans()
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*orphans)
+
+	suite.Assert().Nil(suite.tr.DeletePermanently(task.ID, user.ID))
+	orphans, err = suite.ar.GetOrphans()
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*orphans, 1)
+	suite.Assert().Equal(mockup.ID, (*orphans)[0].ID)
+
+	// test delete
+	suite.Assert().Nil(suite.ar.Delete(mockup.ID))
+	orphans, err = suite.ar.GetOrphans()
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*orphans)
+}
@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+)
+
+type IChecklistItemRepository interface {
+	Create(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error)
+	Get(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) (*entity.ChecklistItem, error)
+	GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.ChecklistItem, error)
+	Save(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error)
+	SetChecked(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID, checked bool) (*entity.ChecklistItem, error)
+	Reorder(taskID entity.TaskID, userID entity.UserID, itemIDs []entity.ChecklistItemID) (*[]entity.ChecklistItem, error)
+	Delete(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) error
+}
+
+type checklistItemRepository struct {
+	db *gorm.DB
+}
+
+func NewChecklistItemRepository(db *gorm.DB) IChecklistItemRepository {
+	return &checklistItemRepository{db: db}
+}
+
+// ownedTask は指定ユーザーが所有するタスクに絞り込む条件
+func (cr *checklistItemRepository) ownedTask(db *gorm.DB, taskID entity.TaskID, userID entity.UserID) *gorm.DB {
+	return db.Where("task_id = ? AND task_id IN (?)", taskID,
+		cr.db.Model(&entity.Task{}).Select("id").Where("id = ? AND user_id = ?", taskID, userID))
+}
+
+// Create は項目を追加する。タスクが無いか他人のものなら ErrTaskNotFound
+func (cr *checklistItemRepository) Create(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error) {
+	if err := cr.db.Transaction(func(tx *gorm.DB) error {
+		var task entity.Task
+		if err := tx.Select("id").Where("id = ? AND user_id = ?", item.TaskID, userID).First(&task).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrTaskNotFound
+			}
+			return err
+		}
+
+		// 末尾に追加する
+		var position int
+		if err := tx.Model(&entity.ChecklistItem{}).Where("task_id = ?", item.TaskID).
+			Select("COALESCE(MAX(position) + 1, 0)").Scan(&position).Error; err != nil {
+			return err
+		}
+		item.Position = position
+		return tx.Create(item).Error
+	}); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (cr *checklistItemRepository) Get(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) (*entity.ChecklistItem, error) {
+	var item = entity.ChecklistItem{}
+	if err := cr.ownedTask(cr.db, taskID, userID).Where("id = ?", itemID).First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (cr *checklistItemRepository) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.ChecklistItem, error) {
+	items := []entity.ChecklistItem{}
+	if err := cr.ownedTask(cr.db, taskID, userID).Order("position").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return &items, nil
+}
+
+func (cr *checklistItemRepository) Save(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error) {
+	selectedItem, err := cr.Get(item.ID, item.TaskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := copier.CopyWithOption(selectedItem, item, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
+		return nil, err
+	}
+	if err := cr.db.Save(selectedItem).Error; err != nil {
+		return nil, err
+	}
+
+	return selectedItem, nil
+}
+
+func (cr *checklistItemRepository) SetChecked(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID, checked bool) (*entity.ChecklistItem, error) {
+	selectedItem, err := cr.Get(itemID, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// copier は false を空値として扱うため Update で直接更新する
+	if err := cr.db.Model(selectedItem).Update("checked", checked).Error; err != nil {
+		return nil, err
+	}
+	selectedItem.Checked = checked
+
+	return selectedItem, nil
+}
+
+func (cr *checklistItemRepository) Reorder(taskID entity.TaskID, userID entity.UserID, itemIDs []entity.ChecklistItemID) (*[]entity.ChecklistItem, error) {
+	items, err := cr.GetAll(taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[entity.ChecklistItemID]int, len(itemIDs))
+	for i, itemID := range itemIDs {
+		positions[itemID] = i
+	}
+	if len(positions) != len(itemIDs) || len(positions) != len(*items) {
+		return nil, entity.ErrChecklistOrderMismatch
+	}
+	for _, item := range *items {
+		if _, ok := positions[item.ID]; !ok {
+			return nil, entity.ErrChecklistOrderMismatch
+		}
+	}
+
+	if err := cr.db.Transaction(func(tx *gorm.DB) error {
+		for i := range *items {
+			item := &(*items)[i]
+			item.Position = positions[item.ID]
+			if err := tx.Model(item).Update("position", item.Position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return cr.GetAll(taskID, userID)
+}
+
+func (cr *checklistItemRepository) Delete(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) error {
+	var item = entity.ChecklistItem{}
+	if err := cr.ownedTask(cr.db, taskID, userID).Where("id = ?", itemID).Delete(&item).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ChecklistItemRepositorySuite struct {
+	tester.DBSQLiteSuite
+	cr gateway.IChecklistItemRepository
+	tr gateway.ITaskRepository
+	ur gateway.IUserRepository
+}
+
+func TestChecklistItemRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ChecklistItemRepositorySuite))
+}
+
+func (suite *ChecklistItemRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.cr = gateway.NewChecklistItemRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *ChecklistItemRepositorySuite) TestChecklistItemRepositoryCRUD() {
+	user, err := suite.ur.Create(&entity.User{Email: "checklist@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-checklist@test.com"})
+	suite.Assert().Nil(err)
+	task, err := suite.tr.Create(&entity.Task{Name: "release", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+
+	// test create appends items
+	first, err := suite.cr.Create(&entity.ChecklistItem{TaskID: task.ID, Text: "build"}, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(0, first.Position)
+	second, err := suite.cr.Create(&entity.ChecklistItem{TaskID: task.ID, Text: "deploy"}, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, second.Position)
+
+	// test another user's task is rejected
+	_, err = suite.cr.Create(&entity.ChecklistItem{TaskID: task.ID, Text: "hijack"}, other.ID)
+	suite.Assert().ErrorIs(err, entity.ErrTaskNotFound)
+
+	// test save
+	updatedItem, err := suite.cr.Save(&entity.ChecklistItem{ID: first.ID, TaskID: task.ID, Text: "build and test"}, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("build and test", updatedItem.Text)
+
+	// test check and uncheck
+	checkedItem, err := suite.cr.SetChecked(first.ID, task.ID, user.ID, true)
+	suite.Assert().Nil(err)
+	suite.Assert().True(checkedItem.Checked)
+	getTask, err := suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(50, *getTask.CompletionPercentage())
+
+	uncheckedItem, err := suite.cr.SetChecked(first.ID, task.ID, user.ID, false)
+	suite.Assert().Nil(err)
+	suite.Assert().False(uncheckedItem.Checked)
+	_, err = suite.cr.SetChecked(first.ID, task.ID, other.ID, true)
+	suite.Assert().NotNil(err)
+
+	// test reorder
+	items, err := suite.cr.Reorder(task.ID, user.ID, []entity.ChecklistItemID{second.ID, first.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(second.ID, (*items)[0].ID)
+	suite.Assert().Equal(first.ID, (*items)[1].ID)
+
+	_, err = suite.cr.Reorder(task.ID, user.ID, []entity.ChecklistItemID{second.ID})
+	suite.Assert().ErrorIs(err, entity.ErrChecklistOrderMismatch)
+	_, err = suite.cr.Reorder(task.ID, user.ID, []entity.ChecklistItemID{second.ID, second.ID})
+	suite.Assert().ErrorIs(err, entity.ErrChecklistOrderMismatch)
+
+	getTask, err = suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("deploy", getTask.ChecklistItems[0].Text)
+
+	// test delete
+	err = suite.cr.Delete(second.ID, task.ID, user.ID)
+	suite.Assert().Nil(err)
+	items, err = suite.cr.GetAll(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*items, 1)
+
+	// test deleting the task permanently deletes its checklist
+	err = suite.tr.Delete(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	err = suite.tr.DeletePermanently(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	var count int64
+	suite.DB.Model(&entity.ChecklistItem{}).Where("task_id = ?", task.ID).Count(&count)
+	suite.Assert().Zero(count)
+}
@@ -0,0 +1,95 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ICommentRepository interface {
+	Create(comment *entity.Comment) (*entity.Comment, error)
+	Get(commentID entity.CommentID, taskID entity.TaskID, userID entity.UserID) (*entity.Comment, error)
+	GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Comment, error)
+	Save(comment *entity.Comment) (*entity.Comment, error)
+	Delete(commentID entity.CommentID, taskID entity.TaskID, userID entity.UserID) error
+}
+
+type commentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) ICommentRepository {
+	return &commentRepository{db: db}
+}
+
+// ownedTask は指定ユーザーが所有するタスクのコメントに絞り込む条件
+func (cr *commentRepository) ownedTask(db *gorm.DB, taskID entity.TaskID, userID entity.UserID) *gorm.DB {
+	return db.Where("task_id = ? AND task_id IN (?)", taskID,
+		cr.db.Model(&entity.Task{}).Select("id").Where("id = ? AND user_id = ?", taskID, userID))
+}
+
+// Create はコメントを投稿する。タスクが無いか他人のものなら ErrTaskNotFound
+func (cr *commentRepository) Create(comment *entity.Comment) (*entity.Comment, error) {
+	var task entity.Task
+	if err := cr.db.Select("id").Where("id = ? AND user_id = ?", comment.TaskID, comment.UserID).First(&task).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrTaskNotFound
+		}
+		return nil, err
+	}
+	if err := cr.db.Create(comment).Error; err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// Get はコメントを返す。タスクの所有者かつコメントの投稿者でなければ ErrCommentNotFound
+func (cr *commentRepository) Get(commentID entity.CommentID, taskID entity.TaskID, userID entity.UserID) (*entity.Comment, error) {
+	var comment = entity.Comment{}
+	if err := cr.ownedTask(cr.db, taskID, userID).
+		Where("id = ? AND user_id = ?", commentID, userID).
+		First(&comment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (cr *commentRepository) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Comment, error) {
+	comments := []entity.Comment{}
+	if err := cr.ownedTask(cr.db, taskID, userID).Order("created_at").Order("id").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return &comments, nil
+}
+
+// Save はコメントの本文を更新し、編集日時を記録する
+func (cr *commentRepository) Save(comment *entity.Comment) (*entity.Comment, error) {
+	selectedComment, err := cr.Get(comment.ID, comment.TaskID, comment.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	editedAt := time.Now()
+	if err := cr.db.Model(selectedComment).Updates(map[string]any{"body": comment.Body, "edited_at": editedAt}).Error; err != nil {
+		return nil, err
+	}
+	selectedComment.Body = comment.Body
+	selectedComment.EditedAt = &editedAt
+
+	return selectedComment, nil
+}
+
+func (cr *commentRepository) Delete(commentID entity.CommentID, taskID entity.TaskID, userID entity.UserID) error {
+	var comment = entity.Comment{}
+	if err := cr.ownedTask(cr.db, taskID, userID).
+		Where("id = ? AND user_id = ?", commentID, userID).
+		Delete(&comment).Error; err != nil {
+		return err
+	}
+	return nil
+}
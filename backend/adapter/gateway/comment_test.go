@@ -0,0 +1,93 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CommentRepositorySuite struct {
+	tester.DBSQLiteSuite
+	cr gateway.ICommentRepository
+	tr gateway.ITaskRepository
+	ur gateway.IUserRepository
+}
+
+func TestCommentRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CommentRepositorySuite))
+}
+
+func (suite *CommentRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.cr = gateway.NewCommentRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *CommentRepositorySuite) TestCommentRepositoryCRUD() {
+	user, err := suite.ur.Create(&entity.User{Email: "comment@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-comment@test.com"})
+	suite.Assert().Nil(err)
+	task, err := suite.tr.Create(&entity.Task{Name: "review", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+
+	// test create
+	first, err := suite.cr.Create(&entity.Comment{TaskID: task.ID, UserID: user.ID, Body: "Looks **good**"})
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(first.EditedAt)
+	_, err = suite.cr.Create(&entity.Comment{TaskID: task.ID, UserID: user.ID, Body: "One more thing"})
+	suite.Assert().Nil(err)
+
+	// test another user's task is rejected
+	_, err = suite.cr.Create(&entity.Comment{TaskID: task.ID, UserID: other.ID, Body: "hijack"})
+	suite.Assert().ErrorIs(err, entity.ErrTaskNotFound)
+
+	// test get all and comment count
+	comments, err := suite.cr.GetAll(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*comments, 2)
+	suite.Assert().Equal("Looks **good**", (*comments)[0].Body)
+	comments, err = suite.cr.GetAll(task.ID, other.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*comments)
+
+	getTask, err := suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, getTask.CommentCount)
+	page, err := suite.tr.GetAll(user.ID, entity.NewTaskQuery())
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, page.Tasks[0].CommentCount)
+
+	// test save records the edit
+	editedComment, err := suite.cr.Save(&entity.Comment{ID: first.ID, TaskID: task.ID, UserID: user.ID, Body: "Looks great"})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("Looks great", editedComment.Body)
+	suite.Assert().NotNil(editedComment.EditedAt)
+	getComment, err := suite.cr.Get(first.ID, task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("Looks great", getComment.Body)
+	suite.Assert().NotNil(getComment.EditedAt)
+
+	// test another user cannot edit or delete
+	_, err = suite.cr.Save(&entity.Comment{ID: first.ID, TaskID: task.ID, UserID: other.ID, Body: "hijack"})
+	suite.Assert().ErrorIs(err, entity.ErrCommentNotFound)
+	suite.Assert().Nil(suite.cr.Delete(first.ID, task.ID, other.ID))
+	_, err = suite.cr.Get(first.ID, task.ID, user.ID)
+	suite.Assert().Nil(err)
+
+	// test delete
+	suite.Assert().Nil(suite.cr.Delete(first.ID, task.ID, user.ID))
+	_, err = suite.cr.Get(first.ID, task.ID, user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrCommentNotFound)
+
+	// test comments are removed with the task
+	suite.Assert().Nil(suite.tr.Delete(task.ID, user.ID))
+	suite.Assert().Nil(suite.tr.DeletePermanently(task.ID, user.ID))
+	var count int64
+	suite.Assert().Nil(suite.DB.Model(&entity.Comment{}).Where("task_id = ?", task.ID).Count(&count).Error)
+	suite.Assert().Zero(count)
+}
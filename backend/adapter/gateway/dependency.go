@@ -0,0 +1,87 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ITaskDependencyRepository interface {
+	Create(dependency *entity.TaskDependency, userID entity.UserID) (*entity.TaskDependency, error)
+	Delete(taskID entity.TaskID, blockerID entity.TaskID, userID entity.UserID) error
+}
+
+type taskDependencyRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskDependencyRepository(db *gorm.DB) ITaskDependencyRepository {
+	return &taskDependencyRepository{db: db}
+}
+
+// ownedTasks は指定ユーザーが所有するタスクの ID を返すサブクエリ。
+// ゴミ箱から復元したときに循環が生じないよう、ゴミ箱のタスクも含める
+func ownedTasks(db *gorm.DB, userID entity.UserID) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Unscoped().Model(&entity.Task{}).Select("id").Where("user_id = ?", userID)
+}
+
+// Create は依存関係を追加する。追加によって循環が生じる場合はエラー。
+// 同時に追加された依存関係で循環が生じないよう、ユーザーの行をロックして循環の確認と追加を 1 つのトランザクションで行う
+func (dr *taskDependencyRepository) Create(dependency *entity.TaskDependency, userID entity.UserID) (*entity.TaskDependency, error) {
+	if err := dr.db.Transaction(func(tx *gorm.DB) error {
+		var user entity.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", userID).Take(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return entity.ErrDependencyTaskNotFound
+			}
+			return err
+		}
+
+		graph, err := dependencyGraph(tx, userID)
+		if err != nil {
+			return err
+		}
+		if entity.CreatesDependencyCycle(graph, dependency.TaskID, dependency.BlockerID) {
+			return entity.ErrDependencyCycle
+		}
+
+		var count int64
+		if err := tx.Model(&entity.Task{}).
+			Where("id IN ? AND user_id = ?", []entity.TaskID{dependency.TaskID, dependency.BlockerID}, userID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count != 2 {
+			return entity.ErrDependencyTaskNotFound
+		}
+		// 既に同じ依存がある場合は何もしない
+		return tx.Where(dependency).FirstOrCreate(dependency).Error
+	}); err != nil {
+		return nil, err
+	}
+	return dependency, nil
+}
+
+// dependencyGraph はユーザーのタスクの依存関係を、タスク ID からそのタスクをブロックしているタスク ID への対応で返す
+func dependencyGraph(tx *gorm.DB, userID entity.UserID) (map[entity.TaskID][]entity.TaskID, error) {
+	dependencies := []entity.TaskDependency{}
+	if err := tx.Where("task_id IN (?)", ownedTasks(tx, userID)).Find(&dependencies).Error; err != nil {
+		return nil, err
+	}
+	graph := make(map[entity.TaskID][]entity.TaskID)
+	for _, dependency := range dependencies {
+		graph[dependency.TaskID] = append(graph[dependency.TaskID], dependency.BlockerID)
+	}
+	return graph, nil
+}
+
+func (dr *taskDependencyRepository) Delete(taskID entity.TaskID, blockerID entity.TaskID, userID entity.UserID) error {
+	var dependency = entity.TaskDependency{}
+	if err := dr.db.Where("task_id = ? AND blocker_id = ? AND task_id IN (?)", taskID, blockerID, ownedTasks(dr.db, userID)).
+		Delete(&dependency).Error; err != nil {
+		return err
+	}
+	return nil
+}
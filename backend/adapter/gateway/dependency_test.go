@@ -0,0 +1,91 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TaskDependencyRepositorySuite struct {
+	tester.DBSQLiteSuite
+	dr gateway.ITaskDependencyRepository
+	tr gateway.ITaskRepository
+	ur gateway.IUserRepository
+}
+
+func TestTaskDependencyRepositorySuite(t *testing.T) {
+	suite.Run(t, new(TaskDependencyRepositorySuite))
+}
+
+func (suite *TaskDependencyRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.dr = gateway.NewTaskDependencyRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *TaskDependencyRepositorySuite) TestTaskDependencyRepositoryCRUD() {
+	user, err := suite.ur.Create(&entity.User{Email: "dependency@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-dependency@test.com"})
+	suite.Assert().Nil(err)
+	design, err := suite.tr.Create(&entity.Task{Name: "design", Status: entity.Status{Name: entity.InProgress}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	build, err := suite.tr.Create(&entity.Task{Name: "build", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	release, err := suite.tr.Create(&entity.Task{Name: "release", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	foreign, err := suite.tr.Create(&entity.Task{Name: "foreign", Status: entity.Status{Name: entity.Todo}, UserID: other.ID})
+	suite.Assert().Nil(err)
+
+	// test create
+	_, err = suite.dr.Create(&entity.TaskDependency{TaskID: build.ID, BlockerID: design.ID}, user.ID)
+	suite.Assert().Nil(err)
+	_, err = suite.dr.Create(&entity.TaskDependency{TaskID: release.ID, BlockerID: build.ID}, user.ID)
+	suite.Assert().Nil(err)
+	_, err = suite.dr.Create(&entity.TaskDependency{TaskID: release.ID, BlockerID: build.ID}, user.ID)
+	suite.Assert().Nil(err)
+
+	getTask, err := suite.tr.Get(build.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]entity.TaskID{design.ID}, getTask.BlockedByIDs())
+	suite.Assert().Equal(entity.InProgress, getTask.Blockers[0].Blocker.Status.Name)
+	suite.Assert().True(getTask.IsBlocked())
+
+	// test another user's tasks are rejected
+	_, err = suite.dr.Create(&entity.TaskDependency{TaskID: build.ID, BlockerID: foreign.ID}, user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrDependencyTaskNotFound)
+	_, err = suite.dr.Create(&entity.TaskDependency{TaskID: build.ID, BlockerID: design.ID}, other.ID)
+	suite.Assert().ErrorIs(err, entity.ErrDependencyTaskNotFound)
+
+	// test cycles are rejected: release waits for build, which waits for design
+	_, err = suite.dr.Create(&entity.TaskDependency{TaskID: design.ID, BlockerID: release.ID}, user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrDependencyCycle)
+	_, err = suite.dr.Create(&entity.TaskDependency{TaskID: design.ID, BlockerID: design.ID}, user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrDependencyCycle)
+	getTask, err = suite.tr.Get(design.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(getTask.Blockers)
+
+	// test delete by another user is ignored
+	suite.Assert().Nil(suite.dr.Delete(release.ID, build.ID, other.ID))
+	getTask, err = suite.tr.Get(release.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]entity.TaskID{build.ID}, getTask.BlockedByIDs())
+
+	// test delete
+	suite.Assert().Nil(suite.dr.Delete(release.ID, build.ID, user.ID))
+	getTask, err = suite.tr.Get(release.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(getTask.Blockers)
+
+	// test deleting a blocker removes its dependencies
+	suite.Assert().Nil(suite.tr.Delete(design.ID, user.ID))
+	getTask, err = suite.tr.Get(build.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(getTask.Blockers)
+	suite.Assert().False(getTask.IsBlocked())
+}
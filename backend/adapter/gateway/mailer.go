@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"backend/entity"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+var errInvalidMailHeader = errors.New("Mail header must not contain line breaks")
+
+// IMailer はユーザーにメールを送る
+type IMailer interface {
+	Send(ctx context.Context, mail *entity.Mail) error
+}
+
+// formatMail は mail を from から送る RFC 5322 のメッセージにする。
+// ヘッダーに改行を含むメールはヘッダーを差し込まれないようにエラーにする
+func formatMail(from string, mail *entity.Mail, now time.Time) ([]byte, error) {
+	for _, header := range []string{from, mail.To, mail.Subject} {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, errInvalidMailHeader
+		}
+	}
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", from)
+	fmt.Fprintf(&message, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mail.Subject))
+	fmt.Fprintf(&message, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	message.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	message.WriteString(strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return message.Bytes(), nil
+}
@@ -0,0 +1,48 @@
+package gateway
+
+import (
+	"backend/entity"
+	"backend/pkg/logger"
+	"context"
+	"os"
+	"sync"
+	"time"
+)
+
+const mailSeparator = "\r\n----------------------------------------\r\n"
+
+type fileMailer struct {
+	mu   sync.Mutex
+	path string
+	from string
+}
+
+// NewFileMailer は送る代わりにメールを path のファイルに追記する。
+// path が空なら宛先と件名だけをログに出力する。本文にはパスワードの再設定などのリンクが含まれるため、ログには出さない。
+// 開発環境やテストでメールの内容を確認するためのもの
+func NewFileMailer(path, from string) IMailer {
+	return &fileMailer{path: path, from: from}
+}
+
+func (fm *fileMailer) Send(_ context.Context, mail *entity.Mail) error {
+	message, err := formatMail(fm.from, mail, time.Now())
+	if err != nil {
+		return err
+	}
+	if fm.path == "" {
+		logger.Info("Mail", "to", mail.To, "subject", mail.Subject)
+		return nil
+	}
+
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	file, err := os.OpenFile(fm.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(message, mailSeparator...)); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
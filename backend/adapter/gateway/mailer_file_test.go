@@ -0,0 +1,32 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileMailer(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "mail.log")
+	mailer := gateway.NewFileMailer(path, "no-reply@test.com")
+
+	// test mails are appended as messages
+	assert.Nil(t, mailer.Send(ctx, &entity.Mail{To: "a@test.com", Subject: "Reset your password", Body: "first"}))
+	assert.Nil(t, mailer.Send(ctx, &entity.Mail{To: "b@test.com", Subject: "パスワード", Body: "second"}))
+	content, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "From: no-reply@test.com\r\nTo: a@test.com\r\nSubject: Reset your password\r\n")
+	assert.Contains(t, string(content), "Subject: =?utf-8?q?")
+	assert.Contains(t, string(content), "\r\n\r\nsecond")
+	assert.Equal(t, 2, strings.Count(string(content), "MIME-Version: 1.0"))
+
+	// test line breaks in headers are rejected
+	assert.NotNil(t, mailer.Send(ctx, &entity.Mail{To: "a@test.com\r\nBcc: c@test.com", Subject: "s", Body: "b"}))
+}
@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"backend/entity"
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+type smtpMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer は SMTP サーバーからメールを送る。サーバーが対応していれば STARTTLS を使い、
+// username が空でなければ PLAIN 認証する
+func NewSMTPMailer(host, port, username, password, from string) IMailer {
+	return &smtpMailer{host: host, port: port, username: username, password: password, from: from}
+}
+
+func (sm *smtpMailer) Send(ctx context.Context, mail *entity.Mail) error {
+	message, err := formatMail(sm.from, mail, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(sm.host, sm.port))
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+	client, err := smtp.NewClient(conn, sm.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: sm.host}); err != nil {
+			return err
+		}
+	}
+	if sm.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", sm.username, sm.password, sm.host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(sm.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(mail.To); err != nil {
+		return err
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,60 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IPasswordResetRepository interface {
+	Create(token *entity.PasswordResetToken) (*entity.PasswordResetToken, error)
+	GetByHash(tokenHash string) (*entity.PasswordResetToken, error)
+	Consume(token *entity.PasswordResetToken, passwordHash string, now time.Time) error
+}
+
+type passwordResetRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordResetRepository(db *gorm.DB) IPasswordResetRepository {
+	return &passwordResetRepository{db: db}
+}
+
+func (pr *passwordResetRepository) Create(token *entity.PasswordResetToken) (*entity.PasswordResetToken, error) {
+	if err := pr.db.Create(token).Error; err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (pr *passwordResetRepository) GetByHash(tokenHash string) (*entity.PasswordResetToken, error) {
+	var token = entity.PasswordResetToken{}
+	if err := pr.db.Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrInvalidPasswordResetToken
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Consume は token を使用済みにしてユーザーのパスワードを passwordHash に変える。
+// 同じユーザーの未使用のトークンもまとめて使用済みにし、同時に使われた場合は先の 1 つだけが成功する
+func (pr *passwordResetRepository) Consume(token *entity.PasswordResetToken, passwordHash string, now time.Time) error {
+	return pr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.PasswordResetToken{}).Where("id = ? AND used_at IS NULL", token.ID).Update("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrInvalidPasswordResetToken
+		}
+		if err := tx.Model(&entity.User{}).Where("id = ?", token.UserID).Update("password", passwordHash).Error; err != nil {
+			return err
+		}
+		return tx.Model(&entity.PasswordResetToken{}).Where("user_id = ? AND used_at IS NULL", token.UserID).
+			Update("used_at", now).Error
+	})
+}
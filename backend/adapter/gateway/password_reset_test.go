@@ -0,0 +1,62 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordResetRepositorySuite struct {
+	tester.DBSQLiteSuite
+	pr gateway.IPasswordResetRepository
+	ur gateway.IUserRepository
+}
+
+func TestPasswordResetRepositorySuite(t *testing.T) {
+	suite.Run(t, new(PasswordResetRepositorySuite))
+}
+
+func (suite *PasswordResetRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.pr = gateway.NewPasswordResetRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *PasswordResetRepositorySuite) TestPasswordResetRepositoryConsume() {
+	user, err := suite.ur.Create(&entity.User{Email: "reset@test.com", Password: "old"})
+	suite.Require().Nil(err)
+	now := time.Now().UTC()
+
+	create := func() (*entity.PasswordResetToken, string) {
+		token, raw, err := entity.NewPasswordResetToken(user.ID, now)
+		suite.Require().Nil(err)
+		_, err = suite.pr.Create(token)
+		suite.Require().Nil(err)
+		return token, raw
+	}
+	_, raw := create()
+	_, otherRaw := create()
+
+	// test consume changes the password
+	token, err := suite.pr.GetByHash(entity.HashToken(raw))
+	suite.Assert().Nil(err)
+	suite.Assert().True(token.IsUsable(now))
+	suite.Assert().Nil(suite.pr.Consume(token, "new", now))
+	stored, err := suite.ur.Get(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("new", stored.Password)
+
+	// test the token and the other tokens of the user can no longer be used
+	suite.Assert().ErrorIs(suite.pr.Consume(token, "again", now), entity.ErrInvalidPasswordResetToken)
+	other, err := suite.pr.GetByHash(entity.HashToken(otherRaw))
+	suite.Assert().Nil(err)
+	suite.Assert().False(other.IsUsable(now))
+
+	// test unknown tokens are invalid
+	_, err = suite.pr.GetByHash(entity.HashToken("unknown"))
+	suite.Assert().ErrorIs(err, entity.ErrInvalidPasswordResetToken)
+}
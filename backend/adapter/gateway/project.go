@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+)
+
+type IProjectRepository interface {
+	Create(project *entity.Project) (*entity.Project, error)
+	Get(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error)
+	GetAll(userID entity.UserID, includeArchived bool) (*[]entity.Project, error)
+	Save(project *entity.Project) (*entity.Project, error)
+	SetArchived(projectID entity.ProjectID, userID entity.UserID, archived bool) (*entity.Project, error)
+	Delete(projectID entity.ProjectID, userID entity.UserID, mode entity.ProjectDeleteMode) error
+}
+
+type projectRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectRepository(db *gorm.DB) IProjectRepository {
+	return &projectRepository{db: db}
+}
+
+func (pr *projectRepository) Create(project *entity.Project) (*entity.Project, error) {
+	if project.Color == "" {
+		project.Color = entity.DefaultProjectColor
+	}
+	if err := pr.db.Create(project).Error; err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+func (pr *projectRepository) Get(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error) {
+	var project = entity.Project{}
+	if err := pr.db.Where("id = ? AND user_id = ?", projectID, userID).First(&project).Error; err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+func (pr *projectRepository) GetAll(userID entity.UserID, includeArchived bool) (*[]entity.Project, error) {
+	projects := []entity.Project{}
+	db := pr.db.Where("user_id = ?", userID)
+	if !includeArchived {
+		db = db.Where("archived = ?", false)
+	}
+	if err := db.Order("name").Find(&projects).Error; err != nil {
+		return nil, err
+	}
+	return &projects, nil
+}
+
+func (pr *projectRepository) Save(project *entity.Project) (*entity.Project, error) {
+	selectedProject, err := pr.Get(project.ID, project.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := copier.CopyWithOption(selectedProject, project, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
+		return nil, err
+	}
+	if err := pr.db.Save(selectedProject).Error; err != nil {
+		return nil, err
+	}
+
+	return selectedProject, nil
+}
+
+func (pr *projectRepository) SetArchived(projectID entity.ProjectID, userID entity.UserID, archived bool) (*entity.Project, error) {
+	selectedProject, err := pr.Get(projectID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// copier は false を空値として扱うため Update で直接更新する
+	if err := pr.db.Model(selectedProject).Update("archived", archived).Error; err != nil {
+		return nil, err
+	}
+	selectedProject.Archived = archived
+
+	return selectedProject, nil
+}
+
+// Delete はプロジェクトを削除する。mode に応じてタスクを受信箱に移動するか、タスクもまとめてゴミ箱に移動する
+func (pr *projectRepository) Delete(projectID entity.ProjectID, userID entity.UserID, mode entity.ProjectDeleteMode) error {
+	return pr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ? AND user_id = ?", projectID, userID).First(&entity.Project{}).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		// 外部キーの ON DELETE SET NULL でタスクがプロジェクトから外れる前に、プロジェクトより先にタスクを移動する
+		var err error
+		if mode == entity.DeleteModeCascade {
+			// タスクはゴミ箱に移動する。復元したタスクは受信箱に戻る
+			err = tx.Where("project_id = ? AND user_id = ?", projectID, userID).Delete(&entity.Task{}).Error
+		} else {
+			err = tx.Model(&entity.Task{}).Where("project_id = ? AND user_id = ?", projectID, userID).Update("project_id", nil).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("id = ? AND user_id = ?", projectID, userID).Delete(&entity.Project{}).Error
+	})
+}
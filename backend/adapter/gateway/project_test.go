@@ -0,0 +1,194 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProjectRepositorySuite struct {
+	tester.DBSQLiteSuite
+	pr gateway.IProjectRepository
+	tr gateway.ITaskRepository
+	cr gateway.IChecklistItemRepository
+	ur gateway.IUserRepository
+}
+
+func TestProjectRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ProjectRepositorySuite))
+}
+
+func (suite *ProjectRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.pr = gateway.NewProjectRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.cr = gateway.NewChecklistItemRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *ProjectRepositorySuite) TestProjectRepositoryCRUD() {
+	user, err := suite.ur.Create(&entity.User{Email: "project@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-project@test.com"})
+	suite.Assert().Nil(err)
+
+	// test create
+	work, err := suite.pr.Create(&entity.Project{Name: "work", UserID: user.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.DefaultProjectColor, work.Color)
+	home, err := suite.pr.Create(&entity.Project{Name: "home", Color: "#00ff00", UserID: user.ID})
+	suite.Assert().Nil(err)
+	_, err = suite.pr.Create(&entity.Project{Name: "work", UserID: user.ID})
+	suite.Assert().NotNil(err)
+
+	// test get
+	getProject, err := suite.pr.Get(work.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("work", getProject.Name)
+	_, err = suite.pr.Get(work.ID, other.ID)
+	suite.Assert().NotNil(err)
+
+	// test save
+	updatedProject, err := suite.pr.Save(&entity.Project{ID: home.ID, Color: "#0000ff", UserID: user.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("home", updatedProject.Name)
+	suite.Assert().Equal(entity.Color("#0000ff"), updatedProject.Color)
+
+	// test archive hides the project by default
+	archivedProject, err := suite.pr.SetArchived(home.ID, user.ID, true)
+	suite.Assert().Nil(err)
+	suite.Assert().True(archivedProject.Archived)
+	projects, err := suite.pr.GetAll(user.ID, false)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*projects, 1)
+	projects, err = suite.pr.GetAll(user.ID, true)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("home", (*projects)[0].Name)
+	suite.Assert().Equal("work", (*projects)[1].Name)
+
+	unarchivedProject, err := suite.pr.SetArchived(home.ID, user.ID, false)
+	suite.Assert().Nil(err)
+	suite.Assert().False(unarchivedProject.Archived)
+
+	projects, err = suite.pr.GetAll(other.ID, true)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*projects)
+}
+
+func (suite *ProjectRepositorySuite) TestProjectTasks() {
+	user, err := suite.ur.Create(&entity.User{Email: "project-tasks@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-project-tasks@test.com"})
+	suite.Assert().Nil(err)
+	work, err := suite.pr.Create(&entity.Project{Name: "work", UserID: user.ID})
+	suite.Assert().Nil(err)
+	home, err := suite.pr.Create(&entity.Project{Name: "home", UserID: user.ID})
+	suite.Assert().Nil(err)
+	foreign, err := suite.pr.Create(&entity.Project{Name: "foreign", UserID: other.ID})
+	suite.Assert().Nil(err)
+
+	report, err := suite.tr.Create(&entity.Task{Name: "report", Status: entity.Status{Name: entity.Todo}, ProjectID: &work.ID, UserID: user.ID})
+	suite.Assert().Nil(err)
+	_, err = suite.tr.Create(&entity.Task{Name: "laundry", Status: entity.Status{Name: entity.Todo}, ProjectID: &home.ID, UserID: user.ID})
+	suite.Assert().Nil(err)
+	_, err = suite.tr.Create(&entity.Task{Name: "idea", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+
+	// test another user's project is rejected
+	_, err = suite.tr.Create(&entity.Task{Name: "hijack", Status: entity.Status{Name: entity.Todo}, ProjectID: &foreign.ID, UserID: user.ID})
+	suite.Assert().ErrorIs(err, entity.ErrProjectNotFound)
+	_, err = suite.tr.MoveToProject(report.ID, user.ID, &foreign.ID)
+	suite.Assert().ErrorIs(err, entity.ErrProjectNotFound)
+
+	// test filter
+	page, err := suite.tr.GetAll(user.ID, &entity.TaskQuery{ProjectID: &work.ID, SortBy: entity.SortByName, Order: entity.Asc, Limit: 10})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"report"}, taskNames(page.Tasks))
+	page, err = suite.tr.GetAll(user.ID, &entity.TaskQuery{Inbox: true, SortBy: entity.SortByName, Order: entity.Asc, Limit: 10})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"idea"}, taskNames(page.Tasks))
+
+	// test move between projects and back to the inbox
+	movedTask, err := suite.tr.MoveToProject(report.ID, user.ID, &home.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(home.ID, *movedTask.ProjectID)
+	movedTask, err = suite.tr.MoveToProject(report.ID, user.ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(movedTask.ProjectID)
+	getTask, err := suite.tr.Get(report.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(getTask.ProjectID)
+	movedTask, err = suite.tr.MoveToProject(report.ID, user.ID, &home.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(home.ID, *movedTask.ProjectID)
+
+	// test delete by another user is ignored
+	suite.Assert().Nil(suite.pr.Delete(home.ID, other.ID, entity.DeleteModeCascade))
+	page, err = suite.tr.GetAll(user.ID, &entity.TaskQuery{ProjectID: &home.ID, SortBy: entity.SortByName, Order: entity.Asc, Limit: 10})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"laundry", "report"}, taskNames(page.Tasks))
+
+	// test delete moves tasks to the inbox
+	suite.Assert().Nil(suite.pr.Delete(home.ID, user.ID, entity.DeleteModeInbox))
+	_, err = suite.pr.Get(home.ID, user.ID)
+	suite.Assert().NotNil(err)
+	page, err = suite.tr.GetAll(user.ID, &entity.TaskQuery{Inbox: true, SortBy: entity.SortByName, Order: entity.Asc, Limit: 10})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"idea", "laundry", "report"}, taskNames(page.Tasks))
+
+	// test cascade delete moves tasks to the trash
+	_, err = suite.tr.MoveToProject(report.ID, user.ID, &work.ID)
+	suite.Assert().Nil(err)
+	_, err = suite.cr.Create(&entity.ChecklistItem{TaskID: report.ID, Text: "draft"}, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(suite.pr.Delete(work.ID, user.ID, entity.DeleteModeCascade))
+	_, err = suite.tr.Get(report.ID, user.ID)
+	suite.Assert().NotNil(err)
+	page, err = suite.tr.GetAll(user.ID, &entity.TaskQuery{SortBy: entity.SortByName, Order: entity.Asc, Limit: 10})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"idea", "laundry"}, taskNames(page.Tasks))
+	trash, err := suite.tr.GetTrash(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"report"}, taskNames(*trash))
+
+	// test restoring a task of a deleted project puts it in the inbox
+	restoredTask, err := suite.tr.Restore(report.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(restoredTask.ProjectID)
+	suite.Assert().Len(restoredTask.ChecklistItems, 1)
+}
+
+func (suite *ProjectRepositorySuite) TestProjectCascadeDeleteWithForeignKeys() {
+	// test the tasks are trashed before ON DELETE SET NULL detaches them from the project
+	sqlDB, err := suite.DB.DB()
+	suite.Assert().Nil(err)
+	sqlDB.SetMaxOpenConns(1)
+	suite.Assert().Nil(suite.DB.Exec("PRAGMA foreign_keys = ON").Error)
+	defer func() {
+		suite.Assert().Nil(suite.DB.Exec("PRAGMA foreign_keys = OFF").Error)
+		sqlDB.SetMaxOpenConns(0)
+	}()
+
+	user, err := suite.ur.Create(&entity.User{Email: "project-foreign-keys@test.com"})
+	suite.Assert().Nil(err)
+	project, err := suite.pr.Create(&entity.Project{Name: "work", UserID: user.ID})
+	suite.Assert().Nil(err)
+	task, err := suite.tr.Create(&entity.Task{Name: "report", Status: entity.Status{Name: entity.Todo}, ProjectID: &project.ID, UserID: user.ID})
+	suite.Assert().Nil(err)
+
+	suite.Assert().Nil(suite.pr.Delete(project.ID, user.ID, entity.DeleteModeCascade))
+	page, err := suite.tr.GetAll(user.ID, &entity.TaskQuery{Inbox: true, SortBy: entity.SortByName, Order: entity.Asc, Limit: 10})
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(page.Tasks)
+	trash, err := suite.tr.GetTrash(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"report"}, taskNames(*trash))
+
+	// test the trashed task is detached from the deleted project
+	var projectID *int
+	suite.Assert().Nil(suite.DB.Table("tasks").Select("project_id").Where("id = ?", task.ID).Scan(&projectID).Error)
+	suite.Assert().Nil(projectID)
+}
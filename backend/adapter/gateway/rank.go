@@ -0,0 +1,135 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// rankColumn はかんばんの 1 列 (ユーザーとステータスの組) の中での Rank を扱う。
+// excludeID のタスクは移動中のタスクとして列から除いて考える
+type rankColumn struct {
+	tx        *gorm.DB
+	userID    entity.UserID
+	statusID  entity.StatusID
+	excludeID entity.TaskID
+}
+
+func (rc *rankColumn) tasks() *gorm.DB {
+	return rc.tx.Model(&entity.Task{}).
+		Where("user_id = ? AND status_id = ? AND id <> ?", rc.userID, rc.statusID, rc.excludeID)
+}
+
+// neighbour は列の中のタスクを取得する。列に無ければ ErrInvalidMove
+func (rc *rankColumn) neighbour(taskID entity.TaskID) (*entity.Task, error) {
+	var task entity.Task
+	if err := rc.tasks().Where("id = ?", taskID).First(&task).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrInvalidMove
+		}
+		return nil, err
+	}
+	return &task, nil
+}
+
+// adjacent は task の直後 (after が false なら直前) のタスクを返す。無ければ nil
+func (rc *rankColumn) adjacent(task *entity.Task, after bool) (*entity.Task, error) {
+	db := rc.tasks()
+	if after {
+		db = db.Where("rank > ? OR (rank = ? AND id > ?)", task.Rank, task.Rank, task.ID).Order("rank").Order("id")
+	} else {
+		db = db.Where("rank < ? OR (rank = ? AND id < ?)", task.Rank, task.Rank, task.ID).Order("rank DESC").Order("id DESC")
+	}
+	var tasks []entity.Task
+	if err := db.Limit(1).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	return &tasks[0], nil
+}
+
+func (rc *rankColumn) last() (*entity.Task, error) {
+	var tasks []entity.Task
+	if err := rc.tasks().Order("rank DESC").Order("id DESC").Limit(1).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	return &tasks[0], nil
+}
+
+// rankBetween は prevID と nextID のタスクの間に置くための Rank を返す
+func (rc *rankColumn) rankBetween(prevID *entity.TaskID, nextID *entity.TaskID) (entity.Rank, error) {
+	var prev, next *entity.Task
+	var err error
+	if prevID != nil {
+		if prev, err = rc.neighbour(*prevID); err != nil {
+			return "", err
+		}
+	}
+	if nextID != nil {
+		if next, err = rc.neighbour(*nextID); err != nil {
+			return "", err
+		}
+	}
+
+	switch {
+	case prev == nil && next == nil:
+		if prev, err = rc.last(); err != nil {
+			return "", err
+		}
+	case next == nil:
+		if next, err = rc.adjacent(prev, true); err != nil {
+			return "", err
+		}
+	case prev == nil:
+		if prev, err = rc.adjacent(next, false); err != nil {
+			return "", err
+		}
+	}
+
+	var prevRank, nextRank entity.Rank
+	if prev != nil {
+		prevRank = prev.Rank
+	}
+	if next == nil {
+		return entity.RankAfter(prevRank), nil
+	}
+	nextRank = next.Rank
+	if nextRank == "" {
+		// 空の Rank より前には置けないため振り直しが必要
+		return "", entity.ErrRankOrder
+	}
+	return entity.RankBetween(prevRank, nextRank)
+}
+
+// rebalance は列のタスクに現在の並び順のまま等間隔の Rank を振り直す
+func (rc *rankColumn) rebalance() error {
+	var ids []entity.TaskID
+	if err := rc.tasks().Order("rank").Order("id").Pluck("id", &ids).Error; err != nil {
+		return err
+	}
+	for i, rank := range entity.SpreadRanks(len(ids)) {
+		if err := rc.tx.Model(&entity.Task{}).Where("id = ?", ids[i]).Update("rank", rank).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rankAtEnd は列の末尾に置くための Rank を返す。Rank が長くなりすぎる場合は列を振り直す
+func rankAtEnd(tx *gorm.DB, userID entity.UserID, statusID entity.StatusID, excludeID entity.TaskID) (entity.Rank, error) {
+	column := rankColumn{tx: tx, userID: userID, statusID: statusID, excludeID: excludeID}
+	rank, err := column.rankBetween(nil, nil)
+	if err != nil || len(rank) <= entity.MaxRankLength {
+		return rank, err
+	}
+	if err := column.rebalance(); err != nil {
+		return "", err
+	}
+	return column.rankBetween(nil, nil)
+}
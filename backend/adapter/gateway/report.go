@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"backend/entity"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IReportRepository interface {
+	GetEstimateReport(userID entity.UserID, query *entity.EstimateReportQuery) (*[]entity.EstimateReportRow, error)
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) IReportRepository {
+	return &reportRepository{db: db}
+}
+
+// weekStartColumn は column の日時を含む週の月曜日 (UTC) を YYYY-MM-DD の文字列で返す SQL 式
+func weekStartColumn(db *gorm.DB, column string) string {
+	if db.Dialector.Name() == "postgres" {
+		return fmt.Sprintf("to_char(date_trunc('week', %s AT TIME ZONE 'UTC'), 'YYYY-MM-DD')", column)
+	}
+	// SQLite は 'weekday 0' で次の日曜日 (日曜日ならその日) に進めてから月曜日まで戻す
+	return fmt.Sprintf("strftime('%%Y-%%m-%%d', %s, 'weekday 0', '-6 days')", column)
+}
+
+// secondsBetweenColumns は from から to までの秒数を返す SQL 式
+func secondsBetweenColumns(db *gorm.DB, from string, to string) string {
+	if db.Dialector.Name() == "postgres" {
+		return fmt.Sprintf("EXTRACT(EPOCH FROM (%s - %s))", to, from)
+	}
+	return fmt.Sprintf("(julianday(%s) - julianday(%s)) * 86400", to, from)
+}
+
+// estimateReportRow は集計結果の 1 行。週の始まりはデータベースによらず文字列で受け取る
+type estimateReportRow struct {
+	UserID         entity.UserID
+	WeekStart      string
+	Unit           *entity.EstimateUnit
+	TaskCount      int
+	EstimateTotal  int
+	CycleSeconds   int64
+	TrackedSeconds int64
+}
+
+// GetEstimateReport は期間内に完了したタスクをユーザー・週・見積もりの単位ごとに SQL で集計する
+func (rr *reportRepository) GetEstimateReport(userID entity.UserID, query *entity.EstimateReportQuery) (*[]entity.EstimateReportRow, error) {
+	weekStart := weekStartColumn(rr.db, "tasks.completed_at")
+	cycleSeconds := secondsBetweenColumns(rr.db, "COALESCE(tasks.work_started_at, tasks.created_at)", "tasks.completed_at")
+
+	rows := []estimateReportRow{}
+	if err := rr.db.Table("tasks").
+		Select("tasks.user_id AS user_id, "+
+			weekStart+" AS week_start, "+
+			"tasks.estimate_unit AS unit, "+
+			"COUNT(*) AS task_count, "+
+			"COALESCE(SUM(tasks.estimate_value), 0) AS estimate_total, "+
+			"CAST(ROUND(COALESCE(SUM("+cycleSeconds+"), 0)) AS BIGINT) AS cycle_seconds, "+
+			"COALESCE(SUM(tracked.seconds), 0) AS tracked_seconds").
+		Joins("LEFT JOIN (SELECT task_id, SUM(duration_seconds) AS seconds FROM time_entries GROUP BY task_id) tracked ON tracked.task_id = tasks.id").
+		Where("tasks.user_id = ? AND tasks.deleted_at IS NULL", userID).
+		Where("tasks.completed_at >= ? AND tasks.completed_at < ?", query.From, query.To).
+		Group("tasks.user_id").Group("week_start").Group("tasks.estimate_unit").
+		Order("week_start").Order("COALESCE(tasks.estimate_unit, '')").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	report := make([]entity.EstimateReportRow, len(rows))
+	for i, row := range rows {
+		weekStart, err := time.Parse(time.DateOnly, row.WeekStart)
+		if err != nil {
+			return nil, err
+		}
+		report[i] = entity.EstimateReportRow{
+			UserID:         row.UserID,
+			WeekStart:      weekStart,
+			Unit:           row.Unit,
+			TaskCount:      row.TaskCount,
+			EstimateTotal:  row.EstimateTotal,
+			CycleSeconds:   row.CycleSeconds,
+			TrackedSeconds: row.TrackedSeconds,
+		}
+	}
+	return &report, nil
+}
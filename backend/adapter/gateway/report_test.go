@@ -0,0 +1,107 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportRepositorySuite struct {
+	tester.DBSQLiteSuite
+	rr  gateway.IReportRepository
+	tr  gateway.ITaskRepository
+	ter gateway.ITimeEntryRepository
+	ur  gateway.IUserRepository
+}
+
+func TestReportRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ReportRepositorySuite))
+}
+
+func (suite *ReportRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.rr = gateway.NewReportRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ter = gateway.NewTimeEntryRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+// completedTask は startedAt に着手して completedAt に完了したタスクを作成する
+func (suite *ReportRepositorySuite) completedTask(userID entity.UserID, estimate *entity.Estimate, startedAt time.Time, completedAt time.Time) *entity.Task {
+	task, err := suite.tr.Create(&entity.Task{Name: "task", Status: entity.Status{Name: entity.Done}, UserID: userID, Estimate: estimate})
+	suite.Require().Nil(err)
+	suite.Require().Nil(suite.DB.Model(task).Updates(map[string]any{"work_started_at": startedAt, "completed_at": completedAt}).Error)
+	return task
+}
+
+func (suite *ReportRepositorySuite) TestGetEstimateReport() {
+	user, err := suite.ur.Create(&entity.User{Email: "report@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-report@test.com"})
+	suite.Assert().Nil(err)
+
+	// 2025-01-06 is a Monday
+	monday := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	first := suite.completedTask(user.ID, &entity.Estimate{Value: 3, Unit: entity.EstimatePoints}, monday, monday.Add(2*time.Hour))
+	suite.completedTask(user.ID, &entity.Estimate{Value: 5, Unit: entity.EstimatePoints}, monday, monday.AddDate(0, 0, 6).Add(time.Hour))
+	suite.completedTask(user.ID, &entity.Estimate{Value: 60, Unit: entity.EstimateMinutes}, monday, monday.Add(90*time.Minute))
+	suite.completedTask(user.ID, nil, monday, monday.AddDate(0, 0, 7).Add(30*time.Minute))
+	suite.completedTask(other.ID, &entity.Estimate{Value: 8, Unit: entity.EstimatePoints}, monday, monday.Add(time.Hour))
+
+	entry := &entity.TimeEntry{TaskID: first.ID, UserID: user.ID, StartedAt: monday}
+	suite.Assert().Nil(entry.Finish(monday.Add(45 * time.Minute)))
+	_, err = suite.ter.Create(entry)
+	suite.Assert().Nil(err)
+
+	// tasks that are not done are not counted
+	_, err = suite.tr.Create(&entity.Task{Name: "open", Status: entity.Status{Name: entity.InProgress}, UserID: user.ID, Estimate: &entity.Estimate{Value: 13, Unit: entity.EstimatePoints}})
+	suite.Assert().Nil(err)
+
+	// test aggregation per week and unit
+	query := &entity.EstimateReportQuery{From: monday.AddDate(0, 0, -7), To: monday.AddDate(0, 0, 14)}
+	rows, err := suite.rr.GetEstimateReport(user.ID, query)
+	suite.Assert().Nil(err)
+	minutes, points := entity.EstimateMinutes, entity.EstimatePoints
+	week := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	suite.Assert().Equal([]entity.EstimateReportRow{
+		{UserID: user.ID, WeekStart: week, Unit: &minutes, TaskCount: 1, EstimateTotal: 60, CycleSeconds: 5400},
+		{UserID: user.ID, WeekStart: week, Unit: &points, TaskCount: 2, EstimateTotal: 8, CycleSeconds: 7200 + (6*24+1)*3600, TrackedSeconds: 2700},
+		{UserID: user.ID, WeekStart: week.AddDate(0, 0, 7), Unit: nil, TaskCount: 1, EstimateTotal: 0, CycleSeconds: (7*24)*3600 + 1800},
+	}, *rows)
+
+	// test the period excludes tasks completed outside of it
+	query = &entity.EstimateReportQuery{From: monday.AddDate(0, 0, 7), To: monday.AddDate(0, 0, 14)}
+	rows, err = suite.rr.GetEstimateReport(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*rows, 1)
+}
+
+func (suite *ReportRepositorySuite) TestGetEstimateReportOnPostgres() {
+	mock, mockGormDB := tester.MockDB()
+	rr := gateway.NewReportRepository(mockGormDB)
+	from := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT tasks.user_id AS user_id, to_char(date_trunc('week', tasks.completed_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD') AS week_start, `+
+		`tasks.estimate_unit AS unit, COUNT(*) AS task_count, COALESCE(SUM(tasks.estimate_value), 0) AS estimate_total, `+
+		`CAST(ROUND(COALESCE(SUM(EXTRACT(EPOCH FROM (tasks.completed_at - COALESCE(tasks.work_started_at, tasks.created_at)))), 0)) AS BIGINT) AS cycle_seconds, `+
+		`COALESCE(SUM(tracked.seconds), 0) AS tracked_seconds FROM "tasks" `+
+		`LEFT JOIN (SELECT task_id, SUM(duration_seconds) AS seconds FROM time_entries GROUP BY task_id) tracked ON tracked.task_id = tasks.id `+
+		`WHERE (tasks.user_id = $1 AND tasks.deleted_at IS NULL) AND (tasks.completed_at >= $2 AND tasks.completed_at < $3) `+
+		`GROUP BY "tasks"."user_id","week_start","tasks"."estimate_unit" ORDER BY week_start,COALESCE(tasks.estimate_unit, '')`)).
+		WithArgs(1, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "week_start", "unit", "task_count", "estimate_total", "cycle_seconds", "tracked_seconds"}).
+			AddRow(1, "2025-01-06", "points", 2, 8, 3600, 0))
+
+	rows, err := rr.GetEstimateReport(1, &entity.EstimateReportQuery{From: from, To: to})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*rows, 1)
+	suite.Assert().Equal(from, (*rows)[0].WeekStart)
+	suite.Assert().Nil(mock.ExpectationsWereMet())
+}
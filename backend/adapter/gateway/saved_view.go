@@ -0,0 +1,77 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+)
+
+type ISavedViewRepository interface {
+	Create(view *entity.SavedView) (*entity.SavedView, error)
+	Get(viewID entity.SavedViewID, userID entity.UserID) (*entity.SavedView, error)
+	GetAll(userID entity.UserID) (*[]entity.SavedView, error)
+	Save(view *entity.SavedView) (*entity.SavedView, error)
+	Delete(viewID entity.SavedViewID, userID entity.UserID) error
+}
+
+type savedViewRepository struct {
+	db *gorm.DB
+}
+
+func NewSavedViewRepository(db *gorm.DB) ISavedViewRepository {
+	return &savedViewRepository{db: db}
+}
+
+func (svr *savedViewRepository) Create(view *entity.SavedView) (*entity.SavedView, error) {
+	if err := svr.db.Create(view).Error; err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (svr *savedViewRepository) Get(viewID entity.SavedViewID, userID entity.UserID) (*entity.SavedView, error) {
+	var view = entity.SavedView{}
+	if err := svr.db.Where("id = ? AND user_id = ?", viewID, userID).First(&view).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrSavedViewNotFound
+		}
+		return nil, err
+	}
+	return &view, nil
+}
+
+func (svr *savedViewRepository) GetAll(userID entity.UserID) (*[]entity.SavedView, error) {
+	views := []entity.SavedView{}
+	if err := svr.db.Where("user_id = ?", userID).Order("name").Order("id").Find(&views).Error; err != nil {
+		return nil, err
+	}
+	return &views, nil
+}
+
+// Save は名前と絞り込み条件を更新する。絞り込み条件は指定されたときだけ丸ごと置き換える
+func (svr *savedViewRepository) Save(view *entity.SavedView) (*entity.SavedView, error) {
+	selectedView, err := svr.Get(view.ID, view.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := view.Filter
+	view.Filter = nil
+	if err := copier.CopyWithOption(selectedView, view, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
+		return nil, err
+	}
+	if filter != nil {
+		selectedView.Filter = filter
+	}
+	if err := svr.db.Save(selectedView).Error; err != nil {
+		return nil, err
+	}
+
+	return selectedView, nil
+}
+
+func (svr *savedViewRepository) Delete(viewID entity.SavedViewID, userID entity.UserID) error {
+	return svr.db.Where("id = ? AND user_id = ?", viewID, userID).Delete(&entity.SavedView{}).Error
+}
@@ -0,0 +1,80 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SavedViewRepositorySuite struct {
+	tester.DBSQLiteSuite
+	svr gateway.ISavedViewRepository
+	ur  gateway.IUserRepository
+}
+
+func TestSavedViewRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SavedViewRepositorySuite))
+}
+
+func (suite *SavedViewRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.svr = gateway.NewSavedViewRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *SavedViewRepositorySuite) TestSavedViewRepositoryCRUD() {
+	user, err := suite.ur.Create(&entity.User{Email: "view@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-view@test.com"})
+	suite.Assert().Nil(err)
+
+	// test create
+	projectID := entity.ProjectID(3)
+	filter := &entity.TaskQuery{
+		Statuses:  []entity.StatusName{entity.Todo, entity.InProgress},
+		TagIDs:    []entity.TagID{1},
+		ProjectID: &projectID,
+		SortBy:    entity.SortByDeadline,
+		Order:     entity.Asc,
+	}
+	urgent, err := suite.svr.Create(&entity.SavedView{Name: "urgent", Filter: filter, UserID: user.ID})
+	suite.Assert().Nil(err)
+	_, err = suite.svr.Create(&entity.SavedView{Name: "all", Filter: &entity.TaskQuery{}, UserID: user.ID})
+	suite.Assert().Nil(err)
+
+	// test get restores the filter
+	view, err := suite.svr.Get(urgent.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(filter, view.Filter)
+	_, err = suite.svr.Get(urgent.ID, other.ID)
+	suite.Assert().ErrorIs(err, entity.ErrSavedViewNotFound)
+
+	// test get all is ordered by name
+	views, err := suite.svr.GetAll(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*views, 2)
+	suite.Assert().Equal("all", (*views)[0].Name)
+
+	// test save keeps the filter unless it is given, and replaces it as a whole
+	view, err = suite.svr.Save(&entity.SavedView{ID: urgent.ID, Name: "renamed", UserID: user.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("renamed", view.Name)
+	suite.Assert().Equal(filter, view.Filter)
+	view, err = suite.svr.Save(&entity.SavedView{ID: urgent.ID, Filter: &entity.TaskQuery{Inbox: true}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	view, err = suite.svr.Get(urgent.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("renamed", view.Name)
+	suite.Assert().Equal(&entity.TaskQuery{Inbox: true}, view.Filter)
+
+	// test delete only removes the user's own view
+	suite.Assert().Nil(suite.svr.Delete(urgent.ID, other.ID))
+	_, err = suite.svr.Get(urgent.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(suite.svr.Delete(urgent.ID, user.ID))
+	_, err = suite.svr.Get(urgent.ID, user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrSavedViewNotFound)
+}
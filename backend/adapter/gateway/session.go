@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ISessionRepository interface {
+	Create(session *entity.Session, token *entity.RefreshToken) (*entity.Session, error)
+	GetRefreshToken(tokenHash string) (*entity.RefreshToken, error)
+	Rotate(used *entity.RefreshToken, next *entity.RefreshToken, now time.Time) error
+	GetAll(userID entity.UserID, now time.Time) (*[]entity.Session, error)
+	IsActive(sessionID entity.SessionID, userID entity.UserID, now time.Time) (bool, error)
+	Revoke(sessionID entity.SessionID, userID entity.UserID, now time.Time) error
+	RevokeAll(userID entity.UserID, now time.Time) error
+}
+
+type sessionRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) ISessionRepository {
+	return &sessionRepository{db: db}
+}
+
+// Create はセッションと最初のリフレッシュトークンを保存する
+func (sr *sessionRepository) Create(session *entity.Session, token *entity.RefreshToken) (*entity.Session, error) {
+	if err := sr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+		token.SessionID = session.ID
+		return tx.Create(token).Error
+	}); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (sr *sessionRepository) GetRefreshToken(tokenHash string) (*entity.RefreshToken, error) {
+	var token = entity.RefreshToken{}
+	if err := sr.db.Preload("Session").Where("token_hash = ?", tokenHash).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Rotate は used を使用済みにして next を発行する。
+// 同時に同じトークンで更新された場合は先の 1 つだけが成功し、残りは ErrRefreshTokenReused になる
+func (sr *sessionRepository) Rotate(used *entity.RefreshToken, next *entity.RefreshToken, now time.Time) error {
+	return sr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.RefreshToken{}).Where("id = ? AND used_at IS NULL", used.ID).Update("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return entity.ErrRefreshTokenReused
+		}
+		if err := tx.Model(&entity.Session{}).Where("id = ?", used.SessionID).
+			Updates(map[string]any{"last_used_at": now, "expires_at": next.ExpiresAt}).Error; err != nil {
+			return err
+		}
+		next.SessionID = used.SessionID
+		return tx.Create(next).Error
+	})
+}
+
+// GetAll は失効していないセッションを最後に使った順に返す
+func (sr *sessionRepository) GetAll(userID entity.UserID, now time.Time) (*[]entity.Session, error) {
+	sessions := []entity.Session{}
+	if err := sr.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Order("last_used_at DESC").Order("id DESC").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return &sessions, nil
+}
+
+// IsActive はユーザーのセッションが失効も期限切れもしていないか調べる
+func (sr *sessionRepository) IsActive(sessionID entity.SessionID, userID entity.UserID, now time.Time) (bool, error) {
+	var count int64
+	if err := sr.db.Model(&entity.Session{}).Where("id = ? AND user_id = ? AND revoked_at IS NULL AND expires_at > ?", sessionID, userID, now).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Revoke はセッションを失効させる。既に失効したセッションや他のユーザーのセッションは何もしない
+func (sr *sessionRepository) Revoke(sessionID entity.SessionID, userID entity.UserID, now time.Time) error {
+	return sr.db.Model(&entity.Session{}).Where("id = ? AND user_id = ? AND revoked_at IS NULL", sessionID, userID).
+		Update("revoked_at", now).Error
+}
+
+// RevokeAll はユーザーのすべてのセッションを失効させる
+func (sr *sessionRepository) RevokeAll(userID entity.UserID, now time.Time) error {
+	return sr.db.Model(&entity.Session{}).Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", now).Error
+}
@@ -0,0 +1,126 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionRepositorySuite struct {
+	tester.DBSQLiteSuite
+	sr gateway.ISessionRepository
+	ur gateway.IUserRepository
+}
+
+func TestSessionRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SessionRepositorySuite))
+}
+
+func (suite *SessionRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.sr = gateway.NewSessionRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *SessionRepositorySuite) TestSessionRepositoryRotate() {
+	user, err := suite.ur.Create(&entity.User{Email: "session@test.com"})
+	suite.Assert().Nil(err)
+	now := time.Now().UTC()
+
+	// test create stores the session with its first token
+	token, raw, err := entity.NewRefreshToken(0, now)
+	suite.Require().Nil(err)
+	session, err := suite.sr.Create(entity.NewSession(user.ID, "laptop", now), token)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(session.ID, token.SessionID)
+
+	stored, err := suite.sr.GetRefreshToken(entity.HashToken(raw))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("laptop", stored.Session.Device)
+	suite.Assert().Nil(stored.UsedAt)
+
+	// test rotate marks the token as used and extends the session
+	later := now.Add(time.Hour)
+	next, _, err := entity.NewRefreshToken(0, later)
+	suite.Require().Nil(err)
+	suite.Assert().Nil(suite.sr.Rotate(stored, next, later))
+	suite.Assert().Equal(session.ID, next.SessionID)
+
+	used, err := suite.sr.GetRefreshToken(entity.HashToken(raw))
+	suite.Assert().Nil(err)
+	suite.Assert().NotNil(used.UsedAt)
+	suite.Assert().True(next.ExpiresAt.Equal(used.Session.ExpiresAt))
+
+	// test the same token cannot be rotated twice
+	another, _, err := entity.NewRefreshToken(0, later)
+	suite.Require().Nil(err)
+	suite.Assert().ErrorIs(suite.sr.Rotate(stored, another, later), entity.ErrRefreshTokenReused)
+
+	// test unknown tokens are invalid
+	_, err = suite.sr.GetRefreshToken(entity.HashToken("unknown"))
+	suite.Assert().ErrorIs(err, entity.ErrInvalidRefreshToken)
+}
+
+func (suite *SessionRepositorySuite) TestSessionRepositoryRevoke() {
+	user, err := suite.ur.Create(&entity.User{Email: "sessions@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-sessions@test.com"})
+	suite.Assert().Nil(err)
+	now := time.Now().UTC()
+
+	create := func(userID entity.UserID, device string, lastUsedAt time.Time) *entity.Session {
+		token, _, err := entity.NewRefreshToken(0, lastUsedAt)
+		suite.Require().Nil(err)
+		session, err := suite.sr.Create(entity.NewSession(userID, device, lastUsedAt), token)
+		suite.Require().Nil(err)
+		return session
+	}
+	phone := create(user.ID, "phone", now.Add(-time.Hour))
+	laptop := create(user.ID, "laptop", now)
+	expired := create(user.ID, "expired", now.Add(-entity.RefreshTokenLifetime-time.Hour))
+	create(other.ID, "other", now)
+
+	// test only unexpired sessions of the user are active
+	isActive := func(sessionID entity.SessionID, userID entity.UserID) bool {
+		active, err := suite.sr.IsActive(sessionID, userID, now)
+		suite.Require().Nil(err)
+		return active
+	}
+	suite.Assert().True(isActive(laptop.ID, user.ID))
+	suite.Assert().False(isActive(laptop.ID, other.ID))
+	suite.Assert().False(isActive(expired.ID, user.ID))
+
+	devices := func() []string {
+		sessions, err := suite.sr.GetAll(user.ID, now)
+		suite.Require().Nil(err)
+		devices := []string{}
+		for _, session := range *sessions {
+			devices = append(devices, session.Device)
+		}
+		return devices
+	}
+
+	// test only active sessions of the user are listed, most recently used first
+	suite.Assert().Equal([]string{"laptop", "phone"}, devices())
+
+	// test revoke is scoped to the user and idempotent
+	suite.Assert().Nil(suite.sr.Revoke(phone.ID, other.ID, now))
+	suite.Assert().Equal([]string{"laptop", "phone"}, devices())
+	suite.Assert().Nil(suite.sr.Revoke(phone.ID, user.ID, now))
+	suite.Assert().Nil(suite.sr.Revoke(phone.ID, user.ID, now))
+	suite.Assert().Equal([]string{"laptop"}, devices())
+
+	// test a revoked session is no longer active
+	suite.Assert().False(isActive(phone.ID, user.ID))
+
+	// test revoke all ends every session of the user only
+	suite.Assert().Nil(suite.sr.RevokeAll(user.ID, now))
+	suite.Assert().Empty(devices())
+	others, err := suite.sr.GetAll(other.ID, now)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*others, 1)
+}
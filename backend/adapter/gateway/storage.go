@@ -0,0 +1,17 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrBlobNotFound = errors.New("Blob not found")
+
+// IBlobStorage は添付ファイルの中身を key で保存・取得するストレージ
+type IBlobStorage interface {
+	Put(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete は key が存在しなくてもエラーにしない
+	Delete(ctx context.Context, key string) error
+}
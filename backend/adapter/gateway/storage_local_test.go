@@ -0,0 +1,38 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	storage, err := gateway.NewLocalStorage(t.TempDir())
+	assert.Nil(t, err)
+
+	// test put and open
+	content := []byte("attachment content")
+	assert.Nil(t, storage.Put(ctx, "tasks/1/file", bytes.NewReader(content), int64(len(content)), "text/plain"))
+	body, err := storage.Open(ctx, "tasks/1/file")
+	assert.Nil(t, err)
+	read, err := io.ReadAll(body)
+	assert.Nil(t, err)
+	assert.Nil(t, body.Close())
+	assert.Equal(t, content, read)
+
+	// test keys outside the root are rejected
+	assert.NotNil(t, storage.Put(ctx, "../escape", bytes.NewReader(content), int64(len(content)), "text/plain"))
+	_, err = storage.Open(ctx, "/etc/passwd")
+	assert.NotNil(t, err)
+
+	// test delete is idempotent
+	assert.Nil(t, storage.Delete(ctx, "tasks/1/file"))
+	assert.Nil(t, storage.Delete(ctx, "tasks/1/file"))
+	_, err = storage.Open(ctx, "tasks/1/file")
+	assert.ErrorIs(t, err, gateway.ErrBlobNotFound)
+}
@@ -0,0 +1,56 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+type s3Storage struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewS3Storage は S3 互換のオブジェクトストレージの bucket に保存するストレージを返す
+func NewS3Storage(client *s3.Client, bucket string) IBlobStorage {
+	return &s3Storage{client: client, bucket: bucket}
+}
+
+func (ss *s3Storage) Put(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error {
+	_, err := ss.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(ss.bucket),
+		Key:           aws.String(key),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+		ContentType:   aws.String(contentType),
+	})
+	return err
+}
+
+func (ss *s3Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := ss.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(ss.bucket),
+		Key:    aws.String(key),
+	})
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return output.Body, nil
+}
+
+// Delete は S3 が存在しないキーの削除も成功として扱うため、そのまま DeleteObject を呼ぶ
+func (ss *s3Storage) Delete(ctx context.Context, key string) error {
+	_, err := ss.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(ss.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
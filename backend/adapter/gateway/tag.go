@@ -0,0 +1,73 @@
+package gateway
+
+import (
+	"backend/entity"
+
+	"github.com/jinzhu/copier"
+	"gorm.io/gorm"
+)
+
+type ITagRepository interface {
+	Create(tag *entity.Tag) (*entity.Tag, error)
+	Get(tagID entity.TagID, userID entity.UserID) (*entity.Tag, error)
+	GetAll(userID entity.UserID) (*[]entity.Tag, error)
+	Save(tag *entity.Tag) (*entity.Tag, error)
+	Delete(tagID entity.TagID, userID entity.UserID) error
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) ITagRepository {
+	return &tagRepository{db: db}
+}
+
+func (tgr *tagRepository) Create(tag *entity.Tag) (*entity.Tag, error) {
+	if err := tgr.db.Create(tag).Error; err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (tgr *tagRepository) Get(tagID entity.TagID, userID entity.UserID) (*entity.Tag, error) {
+	var tag = entity.Tag{}
+	if err := tgr.db.Where("id = ? AND user_id = ?", tagID, userID).First(&tag).Error; err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (tgr *tagRepository) GetAll(userID entity.UserID) (*[]entity.Tag, error) {
+	tags := []entity.Tag{}
+	if err := tgr.db.Where("user_id = ?", userID).Order("name").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return &tags, nil
+}
+
+func (tgr *tagRepository) Save(tag *entity.Tag) (*entity.Tag, error) {
+	selectedTag, err := tgr.Get(tag.ID, tag.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := copier.CopyWithOption(selectedTag, tag, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
+		return nil, err
+	}
+	if err := tgr.db.Save(selectedTag).Error; err != nil {
+		return nil, err
+	}
+
+	return selectedTag, nil
+}
+
+func (tgr *tagRepository) Delete(tagID entity.TagID, userID entity.UserID) error {
+	return tgr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM task_tags WHERE tag_id IN (?)",
+			tx.Model(&entity.Tag{}).Select("id").Where("id = ? AND user_id = ?", tagID, userID)).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ? AND user_id = ?", tagID, userID).Delete(&entity.Tag{}).Error
+	})
+}
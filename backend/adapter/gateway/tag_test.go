@@ -0,0 +1,174 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TagRepositorySuite struct {
+	tester.DBSQLiteSuite
+	tgr gateway.ITagRepository
+	tr  gateway.ITaskRepository
+	ur  gateway.IUserRepository
+}
+
+func TestTagRepositorySuite(t *testing.T) {
+	suite.Run(t, new(TagRepositorySuite))
+}
+
+func (suite *TagRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.tgr = gateway.NewTagRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *TagRepositorySuite) MockDB() sqlmock.Sqlmock {
+	mock, mockGormDB := tester.MockDB()
+	suite.tgr = gateway.NewTagRepository(mockGormDB)
+	return mock
+}
+
+func (suite *TagRepositorySuite) AfterTest(suiteName, testName string) {
+	suite.tgr = gateway.NewTagRepository(suite.DB)
+}
+
+func (suite *TagRepositorySuite) TestTagRepositoryCRUD() {
+	user, err := suite.ur.Create(&entity.User{Email: "tag@test.com"})
+	suite.Assert().Nil(err)
+
+	// test create
+	tag, err := suite.tgr.Create(&entity.Tag{Name: "work", UserID: user.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().NotZero(tag.ID)
+
+	// test get
+	getTag, err := suite.tgr.Get(tag.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("work", getTag.Name)
+
+	_, err = suite.tgr.Get(tag.ID, user.ID+1)
+	suite.Assert().NotNil(err)
+
+	// test get all
+	_, err = suite.tgr.Create(&entity.Tag{Name: "home", UserID: user.ID})
+	suite.Assert().Nil(err)
+	tags, err := suite.tgr.GetAll(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*tags, 2)
+	suite.Assert().Equal("home", (*tags)[0].Name)
+
+	// test save
+	getTag.Name = "office"
+	updatedTag, err := suite.tgr.Save(getTag)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("office", updatedTag.Name)
+
+	// test delete
+	err = suite.tgr.Delete(updatedTag.ID, user.ID)
+	suite.Assert().Nil(err)
+	deletedTag, err := suite.tgr.Get(updatedTag.ID, user.ID)
+	suite.Assert().Nil(deletedTag)
+	suite.Assert().True(strings.Contains("record not found", err.Error()))
+}
+
+func (suite *TagRepositorySuite) TestTaskTagging() {
+	user, err := suite.ur.Create(&entity.User{Email: "tagging@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-tagging@test.com"})
+	suite.Assert().Nil(err)
+
+	work, _ := suite.tgr.Create(&entity.Tag{Name: "work", UserID: user.ID})
+	urgent, _ := suite.tgr.Create(&entity.Tag{Name: "urgent", UserID: user.ID})
+	foreign, _ := suite.tgr.Create(&entity.Tag{Name: "work", UserID: other.ID})
+
+	// test attach on create
+	task, err := suite.tr.Create(&entity.Task{
+		Name:   "report",
+		Status: entity.Status{Name: entity.Todo},
+		UserID: user.ID,
+		Tags:   []entity.Tag{{ID: work.ID}, {ID: urgent.ID}},
+	})
+	suite.Assert().Nil(err)
+	_, err = suite.tr.Create(&entity.Task{
+		Name:   "groceries",
+		Status: entity.Status{Name: entity.Todo},
+		UserID: user.ID,
+		Tags:   []entity.Tag{{ID: work.ID}},
+	})
+	suite.Assert().Nil(err)
+
+	// test tags owned by another user are rejected
+	_, err = suite.tr.Create(&entity.Task{
+		Name:   "invalid",
+		Status: entity.Status{Name: entity.Todo},
+		UserID: user.ID,
+		Tags:   []entity.Tag{{ID: foreign.ID}},
+	})
+	suite.Assert().ErrorIs(err, entity.ErrTagNotFound)
+
+	// test a tag given twice is attached once
+	duplicated, err := suite.tr.Create(&entity.Task{
+		Name:   "duplicated",
+		Status: entity.Status{Name: entity.Todo},
+		UserID: user.ID,
+		Tags:   []entity.Tag{{ID: urgent.ID}, {ID: urgent.ID}},
+	})
+	suite.Require().Nil(err)
+	suite.Assert().Len(duplicated.Tags, 1)
+	suite.Require().Nil(suite.tr.Delete(duplicated.ID, user.ID))
+
+	// test filter by tags
+	query := entity.NewTaskQuery()
+	query.TagIDs = []entity.TagID{work.ID, urgent.ID}
+	page, err := suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"report"}, taskNames(page.Tasks))
+	suite.Assert().Len(page.Tasks[0].Tags, 2)
+
+	// test nil tags keep the current tags
+	updatedTask, err := suite.tr.Save(&entity.Task{ID: task.ID, Name: "weekly report", UserID: user.ID, Status: entity.Status{Name: entity.Todo}}, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(updatedTask.Tags, 2)
+
+	// test detach
+	_, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Status: entity.Status{Name: entity.Todo}, Tags: []entity.Tag{{ID: urgent.ID}}}, nil)
+	suite.Assert().Nil(err)
+	getTask, err := suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(getTask.Tags, 1)
+	suite.Assert().Equal("urgent", getTask.Tags[0].Name)
+
+	_, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Status: entity.Status{Name: entity.Todo}, Tags: []entity.Tag{}}, nil)
+	suite.Assert().Nil(err)
+	getTask, err = suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(getTask.Tags)
+
+	// test deleting a tag detaches it from tasks
+	err = suite.tgr.Delete(work.ID, user.ID)
+	suite.Assert().Nil(err)
+	query = entity.NewTaskQuery()
+	query.TagIDs = []entity.TagID{work.ID}
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(page.Tasks)
+}
+
+func (suite *TagRepositorySuite) TestTagGetFailure() {
+	mockDB := suite.MockDB()
+	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE id = $1 AND user_id = $2 ORDER BY "tags"."id" LIMIT $3`)).WithArgs(1, 1, 1).WillReturnError(errors.New("get error"))
+
+	tag, err := suite.tgr.Get(1, 1)
+	suite.Assert().Nil(tag)
+	suite.Assert().NotNil(err)
+	suite.Assert().Equal("get error", err.Error())
+}
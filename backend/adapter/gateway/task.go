@@ -2,17 +2,28 @@ package gateway
 
 import (
 	"backend/entity"
+	"errors"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ITaskRepository interface {
 	Create(task *entity.Task) (*entity.Task, error)
 	Get(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error)
-	GetAll(userID entity.UserID) (*[]entity.Task, error)
-	Save(task *entity.Task) (*entity.Task, error)
+	GetAll(userID entity.UserID, query *entity.TaskQuery) (*entity.TaskPage, error)
+	GetSmartList(userID entity.UserID, query *entity.SmartListQuery) (*[]entity.Task, error)
+	Save(task *entity.Task, clear []entity.ClearField) (*entity.Task, error)
+	MoveToProject(taskID entity.TaskID, userID entity.UserID, projectID *entity.ProjectID) (*entity.Task, error)
+	Snooze(taskID entity.TaskID, userID entity.UserID, until *time.Time) (*entity.Task, error)
+	Move(taskID entity.TaskID, userID entity.UserID, status entity.StatusName, prevID *entity.TaskID, nextID *entity.TaskID) (*entity.Task, error)
 	Delete(taskID entity.TaskID, userID entity.UserID) error
+	GetTrash(userID entity.UserID) (*[]entity.Task, error)
+	Restore(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error)
+	DeletePermanently(taskID entity.TaskID, userID entity.UserID) error
+	Purge(before time.Time) (int64, error)
 }
 
 type taskRepository struct {
@@ -23,6 +34,23 @@ func NewTaskRepository(db *gorm.DB) ITaskRepository {
 	return &taskRepository{db: db}
 }
 
+// taskColumns はタスクのカラムと、コメント数・記録時間の集計
+const taskColumns = "tasks.*, " +
+	"(SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) AS comment_count, " +
+	"(SELECT COALESCE(SUM(duration_seconds), 0) FROM time_entries WHERE time_entries.task_id = tasks.id) AS tracked_seconds"
+
+// preloadAssociations はタスクの返却時に必要な関連とコメント数、記録済みの作業時間の合計を読み込む
+func preloadAssociations(db *gorm.DB) *gorm.DB {
+	return db.Select(taskColumns).
+		Preload("Status").Preload("User").Preload("Tags").
+		Preload("ChecklistItems", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Blockers", func(db *gorm.DB) *gorm.DB {
+			// ゴミ箱にあるタスクはブロックしていないものとして扱う
+			return db.Where("blocker_id IN (SELECT id FROM tasks WHERE deleted_at IS NULL)").Order("blocker_id")
+		}).
+		Preload("Blockers.Blocker.Status")
+}
+
 func (tr *taskRepository) GetOrCreateStatus(task *entity.Task) error {
 	var status entity.Status
 	if err := tr.db.FirstOrCreate(&status, entity.Status{Name: task.Status.Name}).Error; err != nil {
@@ -33,11 +61,87 @@ func (tr *taskRepository) GetOrCreateStatus(task *entity.Task) error {
 	return nil
 }
 
+// ResolveTags は task.Tags の ID をタスクの所有者のタグに置き換える。他人のタグや存在しないタグが含まれていればエラー
+func (tr *taskRepository) ResolveTags(task *entity.Task) error {
+	if len(task.Tags) == 0 {
+		return nil
+	}
+	// 同じタグが重複して指定されても 1 つとして扱う
+	tagIDs := []entity.TagID{}
+	seen := map[entity.TagID]bool{}
+	for _, tag := range task.Tags {
+		if seen[tag.ID] {
+			continue
+		}
+		seen[tag.ID] = true
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	tags := []entity.Tag{}
+	if err := tr.db.Where("id IN ? AND user_id = ?", tagIDs, task.UserID).Find(&tags).Error; err != nil {
+		return err
+	}
+	if len(tags) != len(tagIDs) {
+		return entity.ErrTagNotFound
+	}
+	task.Tags = tags
+	return nil
+}
+
+// ResolveProject は task.ProjectID がタスクの所有者のプロジェクトであることを確認する
+func (tr *taskRepository) ResolveProject(task *entity.Task) error {
+	if task.ProjectID == nil {
+		return nil
+	}
+	var count int64
+	if err := tr.db.Model(&entity.Project{}).Where("id = ? AND user_id = ?", *task.ProjectID, task.UserID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return entity.ErrProjectNotFound
+	}
+	return nil
+}
+
+// recordEvent はタスクの変更と同じトランザクションで履歴を記録する。更新で変更がなかった場合は記録しない
+func recordEvent(tx *gorm.DB, eventType entity.TaskEventType, task *entity.Task, changes entity.FieldChanges) error {
+	if eventType == entity.TaskUpdated && len(changes) == 0 {
+		return nil
+	}
+	return tx.Create(entity.NewTaskEvent(eventType, task, changes)).Error
+}
+
+// snapshot は履歴の差分を取るために変更前のタスクを複製する。
+// copier は既存のポインタの参照先を書き換えるため、ポインタの先まで複製する
+func snapshot(task *entity.Task) (*entity.Task, error) {
+	before := &entity.Task{}
+	if err := copier.CopyWithOption(before, task, copier.Option{DeepCopy: true}); err != nil {
+		return nil, err
+	}
+	return before, nil
+}
+
 func (tr *taskRepository) Create(task *entity.Task) (*entity.Task, error) {
 	if err := tr.GetOrCreateStatus(task); err != nil {
 		return nil, err
 	}
-	if err := tr.db.Create(task).Error; err != nil {
+	if err := tr.ResolveTags(task); err != nil {
+		return nil, err
+	}
+	if err := tr.ResolveProject(task); err != nil {
+		return nil, err
+	}
+	task.TrackCycle("", time.Now())
+	if err := tr.db.Transaction(func(tx *gorm.DB) error {
+		rank, err := rankAtEnd(tx, task.UserID, task.StatusID, task.ID)
+		if err != nil {
+			return err
+		}
+		task.Rank = rank
+		if err := tx.Create(task).Error; err != nil {
+			return err
+		}
+		return recordEvent(tx, entity.TaskCreated, task, entity.DiffTasks(&entity.Task{}, task))
+	}); err != nil {
 		return nil, err
 	}
 	return task, nil
@@ -45,7 +149,7 @@ func (tr *taskRepository) Create(task *entity.Task) (*entity.Task, error) {
 
 func (tr *taskRepository) Get(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error) {
 	var task = entity.Task{}
-	if err := tr.db.Preload("Status").Preload("User").
+	if err := preloadAssociations(tr.db).
 		Where("id = ? AND user_id = ?", taskID, userID).
 		First(&task).Error; err != nil {
 		return nil, err
@@ -53,18 +157,60 @@ func (tr *taskRepository) Get(taskID entity.TaskID, userID entity.UserID) (*enti
 	return &task, nil
 }
 
-func (tr *taskRepository) GetAll(userID entity.UserID) (*[]entity.Task, error) {
+// GetAll は条件に合うタスクを 1 ページ分返す。query.Text を指定すると全文検索し、一致した箇所を強調表示する
+func (tr *taskRepository) GetAll(userID entity.UserID, query *entity.TaskQuery) (*entity.TaskPage, error) {
+	db := applyTaskFilter(preloadAssociations(tr.db).Where("user_id = ?", userID), query)
+	key := taskSortKeys[query.SortBy]
+
+	words := query.Words()
+	var search taskTextSearch
+	if len(words) > 0 {
+		var err error
+		if search, err = newTaskTextSearch(tr.db); err != nil {
+			return nil, err
+		}
+		db = applyTextSearch(db, search, words)
+		if query.SortBy == entity.SortByRelevance {
+			key = relevanceSortKey(search, words)
+		}
+	}
+
+	db, err := applyTaskOrder(db, query, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// 次ページの有無を判定するため 1 件多く取得する
 	tasks := []entity.Task{}
-	if err := tr.db.Preload("Status").Preload("User").
-		Where("user_id = ?", userID).
-		Order("created_at").
+	if err := db.Limit(query.Limit + 1).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	if search != nil {
+		for i := range tasks {
+			search.render(&tasks[i], words)
+		}
+	}
+
+	page := &entity.TaskPage{Tasks: tasks}
+	if len(tasks) > query.Limit {
+		page.Tasks = tasks[:query.Limit]
+		nextCursor := encodeTaskCursor(query.SortBy, key, &page.Tasks[query.Limit-1])
+		page.NextCursor = &nextCursor
+	}
+	return page, nil
+}
+
+// GetSmartList は完了・アーカイブ以外のタスクから、スマートリストに含まれるものを期限の近い順に返す
+func (tr *taskRepository) GetSmartList(userID entity.UserID, query *entity.SmartListQuery) (*[]entity.Task, error) {
+	tasks := []entity.Task{}
+	if err := applySmartListFilter(preloadAssociations(tr.db).Where("user_id = ?", userID), query).
 		Find(&tasks).Error; err != nil {
 		return nil, err
 	}
 	return &tasks, nil
 }
 
-func (tr *taskRepository) Save(task *entity.Task) (*entity.Task, error) {
+func (tr *taskRepository) Save(task *entity.Task, clear []entity.ClearField) (*entity.Task, error) {
 	selectedTask, err := tr.Get(task.ID, task.UserID)
 	if err != nil {
 		return nil, err
@@ -73,21 +219,279 @@ func (tr *taskRepository) Save(task *entity.Task) (*entity.Task, error) {
 	if err := tr.GetOrCreateStatus(task); err != nil {
 		return nil, err
 	}
+	if err := tr.ResolveTags(task); err != nil {
+		return nil, err
+	}
+	if err := tr.ResolveProject(task); err != nil {
+		return nil, err
+	}
+
+	before, err := snapshot(selectedTask)
+	if err != nil {
+		return nil, err
+	}
 
+	// ステータスが変わったら新しい列の末尾に置く
+	statusChanged := selectedTask.StatusID != task.StatusID
+	previousStatus := selectedTask.Status.Name
+
+	// Tags は nil なら変更なし、空スライスなら全て外すため copier の対象から外して個別に更新する。
+	// その他の関連は各リポジトリで更新するため保存しない
+	tags := task.Tags
+	task.Tags = nil
 	if err := copier.CopyWithOption(selectedTask, task, copier.Option{IgnoreEmpty: true, DeepCopy: true}); err != nil {
 		return nil, err
 	}
-	if err := tr.db.Save(selectedTask).Error; err != nil {
+	// 期限日を変更するときは、終日の期限に戻せるよう時刻とタイムゾーンも空の値を含めて置き換える
+	if task.Deadline != nil {
+		selectedTask.DueAt = task.DueAt
+		selectedTask.TimeZone = task.TimeZone
+	}
+	// 省略した項目は変更しないため、値を消す項目は copier の後で個別に消す
+	selectedTask.Clear(clear)
+	if err := tr.db.Transaction(func(tx *gorm.DB) error {
+		if statusChanged {
+			rank, err := rankAtEnd(tx, selectedTask.UserID, selectedTask.StatusID, selectedTask.ID)
+			if err != nil {
+				return err
+			}
+			selectedTask.Rank = rank
+			selectedTask.TrackCycle(previousStatus, time.Now())
+		}
+		if err := tx.Omit(clause.Associations).Save(selectedTask).Error; err != nil {
+			return err
+		}
+		if tags != nil {
+			selectedTask.Tags = tags
+			if err := tx.Model(selectedTask).Association("Tags").Replace(tags); err != nil {
+				return err
+			}
+		}
+		return recordEvent(tx, entity.TaskUpdated, selectedTask, entity.DiffTasks(before, selectedTask))
+	}); err != nil {
 		return nil, err
 	}
 
 	return selectedTask, nil
 }
 
+// MoveToProject はタスクを別のプロジェクトに移動する。projectID が nil なら受信箱に戻す
+func (tr *taskRepository) MoveToProject(taskID entity.TaskID, userID entity.UserID, projectID *entity.ProjectID) (*entity.Task, error) {
+	selectedTask, err := tr.Get(taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tr.ResolveProject(&entity.Task{UserID: userID, ProjectID: projectID}); err != nil {
+		return nil, err
+	}
+
+	before := *selectedTask
+	selectedTask.ProjectID = projectID
+	if err := tr.db.Transaction(func(tx *gorm.DB) error {
+		// copier は nil を空値として扱うため Update で直接更新する
+		if err := tx.Model(selectedTask).Update("project_id", projectID).Error; err != nil {
+			return err
+		}
+		return recordEvent(tx, entity.TaskUpdated, selectedTask, entity.DiffTasks(&before, selectedTask))
+	}); err != nil {
+		return nil, err
+	}
+
+	return selectedTask, nil
+}
+
+// Snooze はスヌーズの終了日時を更新する。until が nil ならスヌーズを解除する
+func (tr *taskRepository) Snooze(taskID entity.TaskID, userID entity.UserID, until *time.Time) (*entity.Task, error) {
+	selectedTask, err := tr.Get(taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *selectedTask
+	selectedTask.SnoozedUntil = until
+	if err := tr.db.Transaction(func(tx *gorm.DB) error {
+		// copier は nil を空値として扱うため Update で直接更新する
+		if err := tx.Model(selectedTask).Update("snoozed_until", until).Error; err != nil {
+			return err
+		}
+		return recordEvent(tx, entity.TaskUpdated, selectedTask, entity.DiffTasks(&before, selectedTask))
+	}); err != nil {
+		return nil, err
+	}
+
+	return selectedTask, nil
+}
+
+// Move はタスクを status の列の prevID と nextID の間に移動する。
+// 隣のタスクが片方だけ指定された場合はもう片方を列の並びから補い、どちらも無ければ列の末尾に置く
+func (tr *taskRepository) Move(taskID entity.TaskID, userID entity.UserID, status entity.StatusName, prevID *entity.TaskID, nextID *entity.TaskID) (*entity.Task, error) {
+	selectedTask, err := tr.Get(taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	target := &entity.Task{Status: entity.Status{Name: status}}
+	if err := tr.GetOrCreateStatus(target); err != nil {
+		return nil, err
+	}
+	before := *selectedTask
+
+	if err := tr.db.Transaction(func(tx *gorm.DB) error {
+		column := rankColumn{tx: tx, userID: userID, statusID: target.StatusID, excludeID: taskID}
+		rank, err := column.rankBetween(prevID, nextID)
+		if errors.Is(err, entity.ErrRankOrder) || (err == nil && len(rank) > entity.MaxRankLength) {
+			// 同じ Rank のタスクがある、または Rank が長くなりすぎたら列を振り直してからやり直す
+			if err := column.rebalance(); err != nil {
+				return err
+			}
+			rank, err = column.rankBetween(prevID, nextID)
+		}
+		if errors.Is(err, entity.ErrRankOrder) {
+			return entity.ErrInvalidMove
+		}
+		if err != nil {
+			return err
+		}
+
+		previousStatus := selectedTask.Status.Name
+		selectedTask.StatusID = target.StatusID
+		selectedTask.Status = target.Status
+		selectedTask.Rank = rank
+		selectedTask.TrackCycle(previousStatus, time.Now())
+		if err := tx.Model(selectedTask).Updates(map[string]any{
+			"status_id":       target.StatusID,
+			"rank":            rank,
+			"work_started_at": selectedTask.WorkStartedAt,
+			"completed_at":    selectedTask.CompletedAt,
+		}).Error; err != nil {
+			return err
+		}
+		return recordEvent(tx, entity.TaskUpdated, selectedTask, entity.DiffTasks(&before, selectedTask))
+	}); err != nil {
+		return nil, err
+	}
+
+	return selectedTask, nil
+}
+
+// Delete はタスクをゴミ箱に移動する。関連する行は復元できるよう残しておく
 func (tr *taskRepository) Delete(taskID entity.TaskID, userID entity.UserID) error {
-	var task = entity.Task{}
-	if err := tr.db.Where("id = ? AND user_id = ?", taskID, userID).Delete(&task).Error; err != nil {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND user_id = ?", taskID, userID).Delete(&entity.Task{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return recordEvent(tx, entity.TaskDeleted, &entity.Task{ID: taskID, UserID: userID}, nil)
+	})
+}
+
+// trashed はゴミ箱にあるタスクに絞り込む
+func (tr *taskRepository) trashed() *gorm.DB {
+	return tr.db.Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+func (tr *taskRepository) GetTrash(userID entity.UserID) (*[]entity.Task, error) {
+	tasks := []entity.Task{}
+	if err := preloadAssociations(tr.trashed()).Where("user_id = ?", userID).
+		Order("deleted_at DESC").Order("id DESC").Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	return &tasks, nil
+}
+
+// Restore はゴミ箱のタスクを元に戻す。元のプロジェクトが削除されていれば受信箱に戻し、列の末尾に置く
+func (tr *taskRepository) Restore(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error) {
+	if err := tr.db.Transaction(func(tx *gorm.DB) error {
+		var task entity.Task
+		if err := tx.Unscoped().Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", taskID, userID).First(&task).Error; err != nil {
+			return err
+		}
+
+		projectID := task.ProjectID
+		if projectID != nil {
+			var count int64
+			if err := tx.Model(&entity.Project{}).Where("id = ? AND user_id = ?", *projectID, userID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				projectID = nil
+			}
+		}
+		rank, err := rankAtEnd(tx, userID, task.StatusID, task.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&task).Updates(map[string]any{"deleted_at": nil, "project_id": projectID, "rank": rank}).Error; err != nil {
+			return err
+		}
+		return recordEvent(tx, entity.TaskRestored, &task, nil)
+	}); err != nil {
+		return nil, err
+	}
+	return tr.Get(taskID, userID)
+}
+
+// DeletePermanently はゴミ箱のタスクを関連する行とともに削除する
+func (tr *taskRepository) DeletePermanently(taskID entity.TaskID, userID entity.UserID) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", taskID, userID).Delete(&entity.Task{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return deleteTaskRelations(tx, []entity.TaskID{taskID})
+	})
+}
+
+// Purge は before より前にゴミ箱に移動したタスクを完全に削除し、削除した件数を返す
+func (tr *taskRepository) Purge(before time.Time) (int64, error) {
+	var purged int64
+	if err := tr.db.Transaction(func(tx *gorm.DB) error {
+		var taskIDs []entity.TaskID
+		if err := tx.Unscoped().Model(&entity.Task{}).Where("deleted_at < ?", before).Pluck("id", &taskIDs).Error; err != nil {
+			return err
+		}
+		if len(taskIDs) == 0 {
+			return nil
+		}
+		result := tx.Unscoped().Where("id IN ?", taskIDs).Delete(&entity.Task{})
+		if result.Error != nil {
+			return result.Error
+		}
+		purged = result.RowsAffected
+		return deleteTaskRelations(tx, taskIDs)
+	}); err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// deleteTaskRelations は削除したタスクに紐づく行を削除する。
+// SQLite では外部キー制約が無効なため、中間テーブル・依存関係・チェックリスト・コメント・履歴の行も明示的に削除する
+// 添付ファイルはストレージの中身と合わせて消す必要があるため残し、IAttachmentRepository.GetOrphans で回収する
+func deleteTaskRelations(tx *gorm.DB, taskIDs []entity.TaskID) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+	if err := tx.Exec("DELETE FROM task_tags WHERE task_id IN ?", taskIDs).Error; err != nil {
 		return err
 	}
-	return nil
+	if err := tx.Where("task_id IN ? OR blocker_id IN ?", taskIDs, taskIDs).Delete(&entity.TaskDependency{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("task_id IN ?", taskIDs).Delete(&entity.ChecklistItem{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("task_id IN ?", taskIDs).Delete(&entity.Comment{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("task_id IN ?", taskIDs).Delete(&entity.TimeEntry{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("task_id IN ?", taskIDs).Delete(&entity.TaskEvent{}).Error
 }
@@ -0,0 +1,69 @@
+package gateway
+
+import (
+	"backend/entity"
+	"encoding/base64"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// ITaskEventRepository はタスクの履歴を読み出す。履歴は ITaskRepository がタスクの変更と同じトランザクションで記録する
+type ITaskEventRepository interface {
+	GetAll(taskID entity.TaskID, userID entity.UserID, query *entity.TaskEventQuery) (*entity.TaskEventPage, error)
+}
+
+type taskEventRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskEventRepository(db *gorm.DB) ITaskEventRepository {
+	return &taskEventRepository{db: db}
+}
+
+func encodeTaskEventCursor(eventID entity.TaskEventID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(eventID))))
+}
+
+func decodeTaskEventCursor(encoded string) (entity.TaskEventID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, entity.ErrInvalidCursor
+	}
+	eventID, err := strconv.Atoi(string(raw))
+	if err != nil || eventID <= 0 {
+		return 0, entity.ErrInvalidCursor
+	}
+	return entity.TaskEventID(eventID), nil
+}
+
+// GetAll はタスクの履歴を古い順に返す。ゴミ箱にあるタスクの履歴も取得できる
+func (er *taskEventRepository) GetAll(taskID entity.TaskID, userID entity.UserID, query *entity.TaskEventQuery) (*entity.TaskEventPage, error) {
+	var task entity.Task
+	if err := er.db.Unscoped().Select("id").Where("id = ? AND user_id = ?", taskID, userID).First(&task).Error; err != nil {
+		return nil, err
+	}
+
+	db := er.db.Where("task_id = ?", taskID)
+	if query.Cursor != "" {
+		eventID, err := decodeTaskEventCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where("id > ?", eventID)
+	}
+
+	// 次ページの有無を判定するため 1 件多く取得する
+	events := []entity.TaskEvent{}
+	if err := db.Order("id").Limit(query.Limit + 1).Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	page := &entity.TaskEventPage{Events: events}
+	if len(events) > query.Limit {
+		page.Events = events[:query.Limit]
+		nextCursor := encodeTaskEventCursor(page.Events[query.Limit-1].ID)
+		page.NextCursor = &nextCursor
+	}
+	return page, nil
+}
@@ -0,0 +1,153 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TaskEventRepositorySuite struct {
+	tester.DBSQLiteSuite
+	er gateway.ITaskEventRepository
+	tr gateway.ITaskRepository
+	ur gateway.IUserRepository
+	pr gateway.IProjectRepository
+}
+
+func TestTaskEventRepositorySuite(t *testing.T) {
+	suite.Run(t, new(TaskEventRepositorySuite))
+}
+
+func (suite *TaskEventRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.er = gateway.NewTaskEventRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+	suite.pr = gateway.NewProjectRepository(suite.DB)
+}
+
+func (suite *TaskEventRepositorySuite) TestTaskEventRepository() {
+	user, err := suite.ur.Create(&entity.User{Email: "history@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-history@test.com"})
+	suite.Assert().Nil(err)
+
+	// test the task repository records the history of its changes
+	task, err := suite.tr.Create(&entity.Task{Name: "task", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	_, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Name: "renamed", Status: entity.Status{Name: entity.Todo}}, nil)
+	suite.Assert().Nil(err)
+	// saving without changes is not recorded
+	_, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Status: entity.Status{Name: entity.Todo}}, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(suite.tr.Delete(task.ID, user.ID))
+
+	// test pagination in chronological order
+	page, err := suite.er.GetAll(task.ID, user.ID, &entity.TaskEventQuery{Limit: 2})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(page.Events, 2)
+	suite.Assert().Equal(entity.TaskCreated, page.Events[0].Type)
+	suite.Assert().Contains(page.Events[0].Changes, entity.FieldChange{Field: "name", Before: "", After: "task"})
+	suite.Assert().Equal(entity.TaskUpdated, page.Events[1].Type)
+	suite.Assert().Equal(entity.FieldChanges{{Field: "name", Before: "task", After: "renamed"}}, page.Events[1].Changes)
+	suite.Assert().Equal(user.ID, page.Events[1].UserID)
+	suite.Assert().NotNil(page.NextCursor)
+
+	page, err = suite.er.GetAll(task.ID, user.ID, &entity.TaskEventQuery{Cursor: *page.NextCursor, Limit: 2})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(page.Events, 1)
+	suite.Assert().Equal(entity.TaskDeleted, page.Events[0].Type)
+	suite.Assert().Empty(page.Events[0].Changes)
+	suite.Assert().Nil(page.NextCursor)
+
+	// test invalid cursor
+	_, err = suite.er.GetAll(task.ID, user.ID, &entity.TaskEventQuery{Cursor: "invalid", Limit: 2})
+	suite.Assert().ErrorIs(err, entity.ErrInvalidCursor)
+
+	// test history of another user's task
+	_, err = suite.er.GetAll(task.ID, other.ID, &entity.TaskEventQuery{Limit: 2})
+	suite.Assert().NotNil(err)
+
+	// test deleting another user's task records nothing
+	suite.Assert().Nil(suite.tr.Delete(task.ID, other.ID))
+	page, err = suite.er.GetAll(task.ID, user.ID, &entity.TaskEventQuery{Limit: 10})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(page.Events, 3)
+
+	// test removal of the history on permanent delete
+	suite.Assert().Nil(suite.tr.DeletePermanently(task.ID, user.ID))
+	var count int64
+	suite.Assert().Nil(suite.DB.Model(&entity.TaskEvent{}).Where("task_id = ?", task.ID).Count(&count).Error)
+	suite.Assert().Zero(count)
+}
+
+func (suite *TaskEventRepositorySuite) TestRecordsChanges() {
+	user, err := suite.ur.Create(&entity.User{Email: "changes@test.com"})
+	suite.Require().Nil(err)
+	project, err := suite.pr.Create(&entity.Project{Name: "work", UserID: user.ID})
+	suite.Require().Nil(err)
+	task, err := suite.tr.Create(&entity.Task{Name: "task", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Require().Nil(err)
+
+	_, err = suite.tr.Move(task.ID, user.ID, entity.InProgress, nil, nil)
+	suite.Require().Nil(err)
+	until := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	_, err = suite.tr.Snooze(task.ID, user.ID, &until)
+	suite.Require().Nil(err)
+	_, err = suite.tr.MoveToProject(task.ID, user.ID, &project.ID)
+	suite.Require().Nil(err)
+	suite.Require().Nil(suite.tr.Delete(task.ID, user.ID))
+	_, err = suite.tr.Restore(task.ID, user.ID)
+	suite.Require().Nil(err)
+
+	page, err := suite.er.GetAll(task.ID, user.ID, &entity.TaskEventQuery{Limit: 10})
+	suite.Require().Nil(err)
+	suite.Require().Len(page.Events, 6)
+	suite.Assert().Equal(entity.FieldChanges{
+		{Field: "status", Before: "todo", After: "inProgress"},
+	}, page.Events[1].Changes)
+	suite.Assert().Equal(entity.FieldChanges{
+		{Field: "snoozedUntil", Before: nil, After: until.Format(time.RFC3339)},
+	}, page.Events[2].Changes)
+	suite.Assert().Equal(entity.FieldChanges{
+		{Field: "projectId", Before: nil, After: float64(project.ID)},
+	}, page.Events[3].Changes)
+	suite.Assert().Equal(entity.TaskDeleted, page.Events[4].Type)
+	suite.Assert().Equal(entity.TaskRestored, page.Events[5].Type)
+}
+
+func (suite *TaskEventRepositorySuite) TestRollsBackWhenHistoryFails() {
+	user, err := suite.ur.Create(&entity.User{Email: "rollback@test.com"})
+	suite.Require().Nil(err)
+	task, err := suite.tr.Create(&entity.Task{Name: "task", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Require().Nil(err)
+
+	// test the task change is rolled back if its history can't be written
+	historyError := errors.New("history error")
+	suite.Require().Nil(suite.DB.Callback().Create().Before("gorm:create").Register("fail_task_events", func(db *gorm.DB) {
+		if db.Statement.Table == "task_events" {
+			_ = db.AddError(historyError)
+		}
+	}))
+	defer func() {
+		suite.Require().Nil(suite.DB.Callback().Create().Remove("fail_task_events"))
+	}()
+
+	_, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Name: "renamed", Status: entity.Status{Name: entity.Todo}}, nil)
+	suite.Assert().ErrorIs(err, historyError)
+	until := time.Now().Add(time.Hour)
+	_, err = suite.tr.Snooze(task.ID, user.ID, &until)
+	suite.Assert().ErrorIs(err, historyError)
+	suite.Assert().ErrorIs(suite.tr.Delete(task.ID, user.ID), historyError)
+
+	savedTask, err := suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("task", savedTask.Name)
+	suite.Assert().Nil(savedTask.SnoozedUntil)
+}
@@ -0,0 +1,309 @@
+package gateway
+
+import (
+	"backend/entity"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// taskSortKey は並び替え可能なカラムと、カーソルに埋め込む値の変換方法。
+// args は column の式の引数で、引数のある式は ORDER BY に書けないので SELECT での別名 alias で並べる
+type taskSortKey struct {
+	column   string
+	args     []any
+	alias    string
+	nullable bool
+	value    func(task *entity.Task) *string
+	parse    func(value string) (any, error)
+}
+
+func timeCursorValue(t time.Time) *string {
+	value := t.UTC().Format(time.RFC3339Nano)
+	return &value
+}
+
+func parseTimeCursorValue(value string) (any, error) {
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+// priorityRankColumn は priority カラムを緊急度の数値に変換する SQL 式
+func priorityRankColumn() string {
+	var b strings.Builder
+	b.WriteString("CASE priority")
+	for _, priority := range entity.Priorities {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", priority, priority.Rank())
+	}
+	b.WriteString(" ELSE 0 END")
+	return b.String()
+}
+
+// positionColumn はステータス列の順と列の中の Rank をつなげた SQL 式。
+// 列の順は 1 文字なので、文字列として比較するとステータス、Rank の順に並ぶ
+func positionColumn() string {
+	var b strings.Builder
+	b.WriteString("CASE (SELECT name FROM statuses WHERE statuses.id = tasks.status_id)")
+	for _, name := range entity.StatusNames {
+		fmt.Fprintf(&b, " WHEN '%s' THEN '%d'", name, name.Order())
+	}
+	fmt.Fprintf(&b, " ELSE '%d' END || ':' || tasks.rank", len(entity.StatusNames))
+	return b.String()
+}
+
+func positionCursorValue(task *entity.Task) *string {
+	value := fmt.Sprintf("%d:%s", task.Status.Name.Order(), task.Rank)
+	return &value
+}
+
+var taskSortKeys = map[entity.TaskSortField]taskSortKey{
+	entity.SortByCreatedAt: {
+		column: "created_at",
+		value:  func(task *entity.Task) *string { return timeCursorValue(task.CreatedAt) },
+		parse:  parseTimeCursorValue,
+	},
+	entity.SortByDeadline: {
+		column:   "deadline",
+		nullable: true,
+		value: func(task *entity.Task) *string {
+			if task.Deadline == nil {
+				return nil
+			}
+			return timeCursorValue(*task.Deadline)
+		},
+		parse: parseTimeCursorValue,
+	},
+	entity.SortByName: {
+		column: "name",
+		value:  func(task *entity.Task) *string { return &task.Name },
+		parse:  func(value string) (any, error) { return value, nil },
+	},
+	entity.SortByPriority: {
+		column: priorityRankColumn(),
+		value: func(task *entity.Task) *string {
+			value := strconv.Itoa(task.Priority.Rank())
+			return &value
+		},
+		parse: func(value string) (any, error) { return strconv.Atoi(value) },
+	},
+	entity.SortByPosition: {
+		column: positionColumn(),
+		value:  positionCursorValue,
+		parse:  func(value string) (any, error) { return value, nil },
+	},
+}
+
+// relevanceSortKey は全文検索の関連度で並べる。関連度は検索する語によって変わるので都度作る
+func relevanceSortKey(search taskTextSearch, words []string) taskSortKey {
+	column, args := search.rank(words)
+	return taskSortKey{
+		column: column,
+		args:   args,
+		alias:  "search_rank",
+		value: func(task *entity.Task) *string {
+			value := strconv.FormatFloat(task.SearchRank, 'g', -1, 64)
+			return &value
+		},
+		parse: func(value string) (any, error) { return strconv.ParseFloat(value, 64) },
+	}
+}
+
+// applyTextSearch は全文検索で絞り込み、関連度と強調表示を取得する
+func applyTextSearch(db *gorm.DB, search taskTextSearch, words []string) *gorm.DB {
+	condition, args := search.match(words)
+	rank, rankArgs := search.rank(words)
+	name, description, highlightArgs := search.highlight(words)
+	return db.Select(taskColumns+", "+rank+" AS search_rank, "+name+" AS highlighted_name, "+description+" AS highlighted_description",
+		slices.Concat(rankArgs, highlightArgs)...).
+		Where(condition, args...)
+}
+
+// taskCursor は keyset ページングで最後に返した行の位置
+type taskCursor struct {
+	SortBy entity.TaskSortField `json:"s"`
+	Value  *string              `json:"v"`
+	ID     entity.TaskID        `json:"id"`
+}
+
+func encodeTaskCursor(sortBy entity.TaskSortField, key taskSortKey, task *entity.Task) string {
+	cursor := taskCursor{
+		SortBy: sortBy,
+		Value:  key.value(task),
+		ID:     task.ID,
+	}
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeTaskCursor(encoded string, sortBy entity.TaskSortField) (*taskCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, entity.ErrInvalidCursor
+	}
+	var cursor taskCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, entity.ErrInvalidCursor
+	}
+	if cursor.SortBy != sortBy || cursor.ID == 0 {
+		return nil, entity.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+func applyTaskFilter(db *gorm.DB, query *entity.TaskQuery) *gorm.DB {
+	if len(query.Statuses) > 0 {
+		db = db.Where("status_id IN (?)",
+			db.Session(&gorm.Session{NewDB: true}).Model(&entity.Status{}).Select("id").Where("name IN ?", query.Statuses))
+	}
+	if len(query.Priorities) > 0 {
+		db = db.Where("priority IN ?", query.Priorities)
+	}
+	for _, tagID := range query.TagIDs {
+		db = db.Where("id IN (SELECT task_id FROM task_tags WHERE tag_id = ?)", tagID)
+	}
+	if query.ProjectID != nil {
+		db = db.Where("project_id = ?", *query.ProjectID)
+	}
+	if query.Inbox {
+		db = db.Where("project_id IS NULL")
+	}
+	if query.DeadlineFrom != nil {
+		db = db.Where("deadline >= ?", *query.DeadlineFrom)
+	}
+	if query.DeadlineTo != nil {
+		db = db.Where("deadline <= ?", *query.DeadlineTo)
+	}
+	if !query.IncludeSnoozed {
+		db = excludeSnoozed(db, time.Now().UTC())
+	}
+	if query.Search != nil {
+		for _, term := range query.Search.Terms {
+			condition, args := searchTermCondition(term)
+			if term.Negated {
+				condition = "NOT (" + condition + ")"
+			}
+			db = db.Where(condition, args...)
+		}
+	}
+	return db
+}
+
+// likePattern は LIKE の特殊文字をエスケープした、小文字の部分一致のパターンを返す
+func likePattern(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(strings.ToLower(value))
+	return "%" + value + "%"
+}
+
+// searchTermCondition は検索クエリの条件を SQL の条件にする。
+// 否定したときに NULL で条件が消えないよう、NULL を取りうるカラムは COALESCE する
+func searchTermCondition(term entity.SearchTerm) (string, []any) {
+	switch term.Field {
+	case entity.SearchStatus:
+		return "status_id IN (SELECT id FROM statuses WHERE name = ?)", []any{term.Value}
+	case entity.SearchTag:
+		return "id IN (SELECT task_tags.task_id FROM task_tags JOIN tags ON tags.id = task_tags.tag_id WHERE LOWER(tags.name) = ?)",
+			[]any{strings.ToLower(term.Value)}
+	case entity.SearchProject:
+		return "COALESCE(project_id, 0) IN (SELECT id FROM projects WHERE LOWER(name) = ?)", []any{strings.ToLower(term.Value)}
+	case entity.SearchPriority:
+		return "priority = ?", []any{term.Value}
+	case entity.SearchDue:
+		if term.Date == nil {
+			return "deadline IS NULL", nil
+		}
+		if term.Operator == entity.SearchEq {
+			return "COALESCE(deadline = ?, FALSE)", []any{*term.Date}
+		}
+		return fmt.Sprintf("COALESCE(deadline %s ?, FALSE)", term.Operator), []any{*term.Date}
+	case entity.SearchCreated:
+		// 作成日時は日付の範囲で比較する
+		day, nextDay := *term.Date, term.Date.AddDate(0, 0, 1)
+		switch term.Operator {
+		case entity.SearchLt:
+			return "created_at < ?", []any{day}
+		case entity.SearchLe:
+			return "created_at < ?", []any{nextDay}
+		case entity.SearchGt:
+			return "created_at >= ?", []any{nextDay}
+		case entity.SearchGe:
+			return "created_at >= ?", []any{day}
+		}
+		return "created_at >= ? AND created_at < ?", []any{day, nextDay}
+	}
+	pattern := likePattern(term.Value)
+	return `(LOWER(name) LIKE ? ESCAPE '\' OR LOWER(COALESCE(description, '')) LIKE ? ESCAPE '\')`, []any{pattern, pattern}
+}
+
+// excludeSnoozed は now の時点で開始日前やスヌーズ中のタスクを、その日時を過ぎるまで除外する
+func excludeSnoozed(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("(start_at IS NULL OR start_at <= ?) AND (snoozed_until IS NULL OR snoozed_until <= ?)", now, now)
+}
+
+// applySmartListFilter はスマートリストの条件で絞り込む。
+// 期限を過ぎたタスクは overdue にだけ含め、today と upcoming からは除く
+func applySmartListFilter(db *gorm.DB, query *entity.SmartListQuery) *gorm.DB {
+	tomorrow := query.Today.AddDate(0, 0, 1)
+	db = db.Where("status_id NOT IN (?)",
+		db.Session(&gorm.Session{NewDB: true}).Model(&entity.Status{}).Select("id").Where("name IN ?", []entity.StatusName{entity.Done, entity.Archive}))
+	db = excludeSnoozed(db, query.Now)
+
+	switch query.List {
+	case entity.SmartListToday:
+		db = db.Where("deadline >= ? AND deadline < ? AND (due_at IS NULL OR due_at >= ?)", query.Today, tomorrow, query.Now)
+	case entity.SmartListUpcoming:
+		db = db.Where("deadline >= ? AND deadline < ? AND (due_at IS NULL OR due_at >= ?)", tomorrow, tomorrow.AddDate(0, 0, query.Days), query.Now)
+	case entity.SmartListOverdue:
+		db = db.Where("deadline < ? OR due_at < ?", query.Today, query.Now)
+	case entity.SmartListSomeday:
+		db = db.Where("deadline IS NULL")
+	}
+	return db.Order("deadline").Order("due_at IS NULL").Order("due_at").Order(positionColumn()).Order("id")
+}
+
+// applyTaskOrder は並び替えとカーソル以降の絞り込みを行う。
+// NULL を含むカラムは昇順・降順どちらでも NULL を末尾に置き、同値は id で順序を確定させる
+func applyTaskOrder(db *gorm.DB, query *entity.TaskQuery, key taskSortKey) (*gorm.DB, error) {
+	direction, comparison := "ASC", ">"
+	if query.Order == entity.Desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if query.Cursor != "" {
+		cursor, err := decodeTaskCursor(query.Cursor, query.SortBy)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.Value == nil {
+			if !key.nullable {
+				return nil, entity.ErrInvalidCursor
+			}
+			db = db.Where(fmt.Sprintf("(%s IS NULL AND id %s ?)", key.column, comparison), slices.Concat(key.args, []any{cursor.ID})...)
+		} else {
+			value, err := key.parse(*cursor.Value)
+			if err != nil {
+				return nil, entity.ErrInvalidCursor
+			}
+			condition := fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?)", key.column, comparison)
+			args := slices.Concat(key.args, []any{value}, key.args, []any{value, cursor.ID})
+			if key.nullable {
+				condition += fmt.Sprintf(" OR %s IS NULL", key.column)
+				args = append(args, key.args...)
+			}
+			db = db.Where("("+condition+")", args...)
+		}
+	}
+
+	orderColumn := key.column
+	if key.alias != "" {
+		orderColumn = key.alias
+	}
+	if key.nullable {
+		db = db.Order(fmt.Sprintf("CASE WHEN %s IS NULL THEN 1 ELSE 0 END", orderColumn))
+	}
+	return db.Order(fmt.Sprintf("%s %s", orderColumn, direction)).Order(fmt.Sprintf("id %s", direction)), nil
+}
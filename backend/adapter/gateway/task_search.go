@@ -0,0 +1,170 @@
+package gateway
+
+import (
+	"backend/entity"
+	"fmt"
+	"html"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// 強調表示の開始・終了位置の印。データベースでは私用領域の文字で囲み、HTML エスケープした後に <mark> へ置き換える
+const (
+	highlightStart = "\ue000"
+	highlightStop  = "\ue001"
+)
+
+// HasFTS5Table は SQLite に全文検索の仮想テーブルがあるか調べる。テーブルはマイグレーションで作る
+func HasFTS5Table(db *gorm.DB) (bool, error) {
+	var count int64
+	if err := db.Raw("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'tasks_fts'").Scan(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// taskTextSearch はデータベースごとの全文検索の SQL。words はすべて含むタスクを対象にする
+type taskTextSearch interface {
+	// match は一致するタスクに絞り込む条件
+	match(words []string) (string, []any)
+	// rank は関連度の式。大きいほど関連度が高い
+	rank(words []string) (string, []any)
+	// highlight は強調表示した名前と説明の式
+	highlight(words []string) (string, string, []any)
+	// render は取得した強調表示を HTML にする
+	render(task *entity.Task, words []string)
+}
+
+func newTaskTextSearch(db *gorm.DB) (taskTextSearch, error) {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return postgresTextSearch{}, nil
+	case "sqlite":
+		exists, err := HasFTS5Table(db)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return fts5TextSearch{}, nil
+		}
+	}
+	return likeTextSearch{}, nil
+}
+
+// renderHighlight は印の付いたテキストを HTML エスケープし、印を <mark> にする
+func renderHighlight(marked string) string {
+	return strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>").Replace(html.EscapeString(marked))
+}
+
+type postgresTextSearch struct{}
+
+func (postgresTextSearch) match(words []string) (string, []any) {
+	return "search_vector @@ plainto_tsquery('simple', ?)", []any{strings.Join(words, " ")}
+}
+
+func (postgresTextSearch) rank(words []string) (string, []any) {
+	return "CAST(ts_rank(search_vector, plainto_tsquery('simple', ?)) AS double precision)", []any{strings.Join(words, " ")}
+}
+
+func (postgresTextSearch) highlight(words []string) (string, string, []any) {
+	query := strings.Join(words, " ")
+	selectors := fmt.Sprintf("StartSel=%s, StopSel=%s", highlightStart, highlightStop)
+	return "ts_headline('simple', name, plainto_tsquery('simple', ?), ?)",
+		"ts_headline('simple', COALESCE(description, ''), plainto_tsquery('simple', ?), ?)",
+		[]any{query, selectors + ", HighlightAll=true", query, selectors + ", MaxWords=35, MinWords=15, MaxFragments=2"}
+}
+
+func (postgresTextSearch) render(task *entity.Task, words []string) {
+	task.HighlightedName = renderHighlight(task.HighlightedName)
+	task.HighlightedDescription = renderHighlight(task.HighlightedDescription)
+}
+
+type fts5TextSearch struct{}
+
+// matchQuery は語をそれぞれ引用符で囲んだ FTS5 のクエリにする。利用者の入力を演算子として解釈させない
+func (fts5TextSearch) matchQuery(words []string) string {
+	quoted := make([]string, len(words))
+	for i, word := range words {
+		quoted[i] = `"` + word + `"`
+	}
+	return strings.Join(quoted, " ")
+}
+
+func (s fts5TextSearch) match(words []string) (string, []any) {
+	return "id IN (SELECT rowid FROM tasks_fts WHERE tasks_fts MATCH ?)", []any{s.matchQuery(words)}
+}
+
+// rank は名前の一致を説明の 10 倍に重み付けした bm25 を使う。bm25 は小さいほど関連度が高いので符号を反転する
+func (s fts5TextSearch) rank(words []string) (string, []any) {
+	return "(SELECT -bm25(tasks_fts, 10.0, 1.0) FROM tasks_fts WHERE tasks_fts MATCH ? AND tasks_fts.rowid = tasks.id)",
+		[]any{s.matchQuery(words)}
+}
+
+func (s fts5TextSearch) highlight(words []string) (string, string, []any) {
+	query := s.matchQuery(words)
+	return "(SELECT highlight(tasks_fts, 0, ?, ?) FROM tasks_fts WHERE tasks_fts MATCH ? AND tasks_fts.rowid = tasks.id)",
+		"(SELECT snippet(tasks_fts, 1, ?, ?, '…', 24) FROM tasks_fts WHERE tasks_fts MATCH ? AND tasks_fts.rowid = tasks.id)",
+		[]any{highlightStart, highlightStop, query, highlightStart, highlightStop, query}
+}
+
+func (fts5TextSearch) render(task *entity.Task, words []string) {
+	task.HighlightedName = renderHighlight(task.HighlightedName)
+	task.HighlightedDescription = renderHighlight(task.HighlightedDescription)
+}
+
+// likeTextSearch は FTS5 の無い SQLite 用の代替。語の部分一致で探し、名前に含まれる語を説明の 2 倍に数える
+type likeTextSearch struct{}
+
+func (likeTextSearch) match(words []string) (string, []any) {
+	conditions := make([]string, len(words))
+	args := []any{}
+	for i, word := range words {
+		conditions[i] = `(LOWER(name) LIKE ? ESCAPE '\' OR LOWER(COALESCE(description, '')) LIKE ? ESCAPE '\')`
+		args = append(args, likePattern(word), likePattern(word))
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+func (likeTextSearch) rank(words []string) (string, []any) {
+	scores := make([]string, len(words))
+	args := []any{}
+	for i, word := range words {
+		scores[i] = `CASE WHEN LOWER(name) LIKE ? ESCAPE '\' THEN 2.0 ELSE 0.0 END + ` +
+			`CASE WHEN LOWER(COALESCE(description, '')) LIKE ? ESCAPE '\' THEN 1.0 ELSE 0.0 END`
+		args = append(args, likePattern(word), likePattern(word))
+	}
+	return "(" + strings.Join(scores, " + ") + ")", args
+}
+
+func (likeTextSearch) highlight(words []string) (string, string, []any) {
+	return "name", "COALESCE(description, '')", nil
+}
+
+func (likeTextSearch) render(task *entity.Task, words []string) {
+	task.HighlightedName = renderHighlight(markWords(task.HighlightedName, words))
+	task.HighlightedDescription = renderHighlight(markWords(task.HighlightedDescription, words))
+}
+
+// markWords は text の中で words のいずれかに大文字小文字を区別せず一致する部分に印を付ける
+func markWords(text string, words []string) string {
+	runes := []rune(text)
+	var b strings.Builder
+	for i := 0; i < len(runes); {
+		length := 0
+		for _, word := range words {
+			wordLength := len([]rune(word))
+			if wordLength > length && i+wordLength <= len(runes) && strings.EqualFold(string(runes[i:i+wordLength]), word) {
+				length = wordLength
+			}
+		}
+		if length == 0 {
+			b.WriteRune(runes[i])
+			i++
+			continue
+		}
+		b.WriteString(highlightStart + string(runes[i:i+length]) + highlightStop)
+		i += length
+	}
+	return b.String()
+}
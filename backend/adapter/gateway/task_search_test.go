@@ -0,0 +1,88 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TaskSearchPostgresSuite は Postgres の tsvector と GIN インデックスによる全文検索を確かめる
+type TaskSearchPostgresSuite struct {
+	tester.DBPostgresSuite
+	tr gateway.ITaskRepository
+	ur gateway.IUserRepository
+}
+
+func TestTaskSearchPostgresSuite(t *testing.T) {
+	suite.Run(t, new(TaskSearchPostgresSuite))
+}
+
+func (suite *TaskSearchPostgresSuite) SetupSuite() {
+	suite.DBPostgresSuite.SetupSuite()
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *TaskSearchPostgresSuite) TestFullTextSearch() {
+	user, err := suite.ur.Create(&entity.User{Email: "fulltext@test.com"})
+	suite.Require().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "fulltext-other@test.com"})
+	suite.Require().Nil(err)
+
+	for _, task := range []*entity.Task{
+		{Name: "Draft", Description: "Quarterly report for <finance>", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "Quarterly report", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "Report archive", Description: "old quarterly numbers", Status: entity.Status{Name: entity.Done}, UserID: user.ID},
+		{Name: "Weekly report", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "Quarterly report", Status: entity.Status{Name: entity.Todo}, UserID: other.ID},
+	} {
+		_, err := suite.tr.Create(task)
+		suite.Require().Nil(err)
+	}
+
+	search := func(text string, cursor string, limit int) *entity.TaskPage {
+		query := entity.NewTaskQuery()
+		query.Text = text
+		query.SortBy = entity.SortByRelevance
+		query.Order = entity.Desc
+		query.Cursor = cursor
+		query.Limit = limit
+		page, err := suite.tr.GetAll(user.ID, query)
+		suite.Require().Nil(err)
+		return page
+	}
+
+	// test the search vector column and its GIN index are created by the migration
+	var indexes int64
+	suite.Require().Nil(suite.DB.Raw("SELECT COUNT(*) FROM pg_indexes WHERE tablename = 'tasks' AND indexname = 'idx_tasks_search_vector'").Scan(&indexes).Error)
+	suite.Assert().Equal(int64(1), indexes)
+
+	// test only the user's tasks containing every word are returned, matches in the name first
+	page := search("QUARTERLY report", "", 10)
+	suite.Assert().Equal([]string{"Quarterly report", "Report archive", "Draft"}, taskNames(page.Tasks))
+	suite.Assert().Equal("<mark>Quarterly</mark> <mark>report</mark>", page.Tasks[0].HighlightedName)
+	suite.Assert().Equal("Draft", page.Tasks[2].HighlightedName)
+	suite.Assert().Contains(page.Tasks[2].HighlightedDescription, "<mark>Quarterly</mark> <mark>report</mark>")
+	suite.Assert().Greater(page.Tasks[0].SearchRank, page.Tasks[2].SearchRank)
+
+	// test pages follow the relevance order
+	first := search("quarterly report", "", 2)
+	suite.Assert().Equal([]string{"Quarterly report", "Report archive"}, taskNames(first.Tasks))
+	suite.Require().NotNil(first.NextCursor)
+	second := search("quarterly report", *first.NextCursor, 2)
+	suite.Assert().Equal([]string{"Draft"}, taskNames(second.Tasks))
+	suite.Assert().Nil(second.NextCursor)
+
+	// test renamed tasks are searched by their new name
+	weekly := search("weekly", "", 10)
+	suite.Require().Len(weekly.Tasks, 1)
+	renamed := weekly.Tasks[0]
+	renamed.Name = "Monthly summary"
+	_, err = suite.tr.Save(&renamed, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(search("weekly", "", 10).Tasks)
+	suite.Assert().Equal([]string{"Monthly summary"}, taskNames(search("monthly", "", 10).Tasks))
+}
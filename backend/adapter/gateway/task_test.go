@@ -3,11 +3,13 @@ package gateway_test
 import (
 	"backend/adapter/gateway"
 	"backend/entity"
+	"backend/pkg"
 	"backend/pkg/tester"
 	"errors"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/suite"
@@ -49,10 +51,13 @@ func (suite *TaskRepositorySuite) TestTaskRepositoryCRUD() {
 
 	user, _ = suite.ur.Create(user)
 
+	recurrence, _ := entity.ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO,FR")
 	task := &entity.Task{
-		Name:   "test",
-		Status: entity.Status{Name: entity.StatusName("todo")},
-		User:   *user,
+		Name:        "test",
+		Description: "# memo",
+		Status:      entity.Status{Name: entity.StatusName("todo")},
+		User:        *user,
+		Recurrence:  recurrence,
 	}
 
 	// test create
@@ -69,6 +74,8 @@ func (suite *TaskRepositorySuite) TestTaskRepositoryCRUD() {
 	getTask, err := suite.tr.Get(task.ID, user.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("test", getTask.Name)
+	suite.Assert().Equal("# memo", getTask.Description)
+	suite.Assert().Equal("FREQ=WEEKLY;BYDAY=MO,FR", getTask.Recurrence.String())
 	suite.Assert().NotZero(getTask.Status.ID)
 	suite.Assert().Equal(entity.StatusName("todo"), getTask.Status.Name)
 
@@ -77,12 +84,31 @@ func (suite *TaskRepositorySuite) TestTaskRepositoryCRUD() {
 
 	// test save
 	getTask.Name = "updated"
-	updatedTask, err := suite.tr.Save(getTask)
+	updatedTask, err := suite.tr.Save(getTask, nil)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("updated", updatedTask.Name)
 	suite.Assert().NotZero(updatedTask.Status.ID)
 	suite.Assert().Equal(entity.StatusName("todo"), updatedTask.Status.Name)
 
+	// test a task without an estimate keeps it NULL
+	suite.Assert().Nil(getTask.Estimate)
+	suite.Assert().Nil(updatedTask.Estimate)
+	var estimateUnit *string
+	suite.Assert().Nil(suite.DB.Table("tasks").Select("estimate_unit").Where("id = ?", task.ID).Scan(&estimateUnit).Error)
+	suite.Assert().Nil(estimateUnit)
+
+	// test an empty description is kept unless it is cleared explicitly
+	updatedTask, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Status: entity.Status{Name: entity.StatusName("todo")}}, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("# memo", updatedTask.Description)
+	updatedTask, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Status: entity.Status{Name: entity.StatusName("todo")}}, []entity.ClearField{entity.ClearDescription})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("", updatedTask.Description)
+	getTask, err = suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("", getTask.Description)
+	suite.Assert().Equal("updated", getTask.Name)
+
 	// test delete
 	err = suite.tr.Delete(updatedTask.ID, updatedTask.UserID)
 	suite.Assert().Nil(err)
@@ -91,6 +117,48 @@ func (suite *TaskRepositorySuite) TestTaskRepositoryCRUD() {
 	suite.Assert().True(strings.Contains("record not found", err.Error()))
 }
 
+func (suite *TaskRepositorySuite) TestTaskSaveClearsFields() {
+	user, err := suite.ur.Create(&entity.User{Email: "clear@test.com"})
+	suite.Require().Nil(err)
+
+	recurrence, _ := entity.ParseRecurrenceRule("FREQ=DAILY")
+	deadline := pkg.Str2time("2025-01-31")
+	startAt := pkg.Str2time("2025-01-29")
+	task := &entity.Task{
+		Name:       "test",
+		Status:     entity.Status{Name: entity.Todo},
+		UserID:     user.ID,
+		Recurrence: recurrence,
+		Estimate:   &entity.Estimate{Value: 30, Unit: entity.EstimateMinutes},
+		StartAt:    &startAt,
+	}
+	suite.Require().Nil(task.SetDeadline(&deadline, "17:00", "Asia/Tokyo"))
+	task, err = suite.tr.Create(task)
+	suite.Require().Nil(err)
+
+	// test omitted fields keep their values
+	savedTask, err := suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Status: entity.Status{Name: entity.Todo}}, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().NotNil(savedTask.Recurrence)
+	suite.Assert().NotNil(savedTask.Deadline)
+	suite.Assert().NotNil(savedTask.Estimate)
+	suite.Assert().NotNil(savedTask.StartAt)
+
+	// test the listed fields are removed
+	clear := []entity.ClearField{entity.ClearRecurrence, entity.ClearDeadline, entity.ClearEstimate, entity.ClearStartAt}
+	_, err = suite.tr.Save(&entity.Task{ID: task.ID, UserID: user.ID, Status: entity.Status{Name: entity.Todo}}, clear)
+	suite.Assert().Nil(err)
+	clearedTask, err := suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(clearedTask.Recurrence)
+	suite.Assert().Nil(clearedTask.Deadline)
+	suite.Assert().Nil(clearedTask.DueAt)
+	suite.Assert().Equal("", clearedTask.TimeZone)
+	suite.Assert().Nil(clearedTask.Estimate)
+	suite.Assert().Nil(clearedTask.StartAt)
+	suite.Assert().Equal("test", clearedTask.Name)
+}
+
 func (suite *TaskRepositorySuite) TestTaskCreateFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "statuses" WHERE "statuses"."name" = $1 ORDER BY "statuses"."id" LIMIT $2`)).WithArgs("todo", 1).WillReturnError(errors.New("create error"))
@@ -108,7 +176,7 @@ func (suite *TaskRepositorySuite) TestTaskCreateFailure() {
 
 func (suite *TaskRepositorySuite) TestTaskGetFailure() {
 	mockDB := suite.MockDB()
-	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE id = $1 AND user_id = $2 ORDER BY "tasks"."id" LIMIT $3`)).WithArgs(1, 1, 1).WillReturnError(errors.New("get error"))
+	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT tasks.*, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) AS comment_count, (SELECT COALESCE(SUM(duration_seconds), 0) FROM time_entries WHERE time_entries.task_id = tasks.id) AS tracked_seconds FROM "tasks" WHERE (id = $1 AND user_id = $2) AND "tasks"."deleted_at" IS NULL ORDER BY "tasks"."id" LIMIT $3`)).WithArgs(1, 1, 1).WillReturnError(errors.New("get error"))
 
 	task, err := suite.tr.Get(1, 1)
 	suite.Assert().Nil(task)
@@ -118,7 +186,7 @@ func (suite *TaskRepositorySuite) TestTaskGetFailure() {
 
 func (suite *TaskRepositorySuite) TestTaskSaveFailure() {
 	mockDB := suite.MockDB()
-	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tasks" WHERE id = $1 AND user_id = $2 ORDER BY "tasks"."id" LIMIT $3`)).WithArgs(1, 1, 1).WillReturnError(errors.New("save error"))
+	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT tasks.*, (SELECT COUNT(*) FROM comments WHERE comments.task_id = tasks.id) AS comment_count, (SELECT COALESCE(SUM(duration_seconds), 0) FROM time_entries WHERE time_entries.task_id = tasks.id) AS tracked_seconds FROM "tasks" WHERE (id = $1 AND user_id = $2) AND "tasks"."deleted_at" IS NULL ORDER BY "tasks"."id" LIMIT $3`)).WithArgs(1, 1, 1).WillReturnError(errors.New("save error"))
 
 	task := &entity.Task{
 		ID:     1,
@@ -127,7 +195,7 @@ func (suite *TaskRepositorySuite) TestTaskSaveFailure() {
 		UserID: 1,
 	}
 
-	task, err := suite.tr.Save(task)
+	task, err := suite.tr.Save(task, nil)
 	suite.Assert().Nil(task)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("save error", err.Error())
@@ -136,7 +204,7 @@ func (suite *TaskRepositorySuite) TestTaskSaveFailure() {
 func (suite *TaskRepositorySuite) TestTaskDeleteFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
-	mockDB.ExpectExec(regexp.QuoteMeta(`DELETE FROM "tasks" WHERE id = $1 AND user_id = $2`)).WithArgs(1, 1).WillReturnError(errors.New("delete error"))
+	mockDB.ExpectExec(regexp.QuoteMeta(`UPDATE "tasks" SET "deleted_at"=$1 WHERE (id = $2 AND user_id = $3) AND "tasks"."deleted_at" IS NULL`)).WithArgs(sqlmock.AnyArg(), 1, 1).WillReturnError(errors.New("delete error"))
 	mockDB.ExpectRollback()
 	mockDB.ExpectCommit()
 
@@ -144,3 +212,478 @@ func (suite *TaskRepositorySuite) TestTaskDeleteFailure() {
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("delete error", err.Error())
 }
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryGetAll() {
+	user, err := suite.ur.Create(&entity.User{Email: "getall@test.com"})
+	suite.Assert().Nil(err)
+
+	deadline1 := pkg.Str2time("2025-01-10")
+	deadline2 := pkg.Str2time("2025-01-20")
+	deadline3 := pkg.Str2time("2025-01-30")
+	for _, task := range []*entity.Task{
+		{Name: "c", Status: entity.Status{Name: entity.Todo}, UserID: user.ID, Deadline: &deadline2, Priority: entity.PriorityUrgent},
+		{Name: "a", Status: entity.Status{Name: entity.Done}, UserID: user.ID, Deadline: &deadline1, Priority: entity.PriorityHigh},
+		{Name: "d", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "b", Status: entity.Status{Name: entity.InProgress}, UserID: user.ID, Deadline: &deadline3, Priority: entity.PriorityUrgent},
+	} {
+		_, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+	}
+
+	// test default order is by status column, then by position in the column
+	page, err := suite.tr.GetAll(user.ID, entity.NewTaskQuery())
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(page.NextCursor)
+	suite.Assert().Equal([]string{"c", "d", "b", "a"}, taskNames(page.Tasks))
+
+	// test created at order
+	query := entity.NewTaskQuery()
+	query.SortBy = entity.SortByCreatedAt
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"c", "a", "d", "b"}, taskNames(page.Tasks))
+
+	// test status filter
+	query = entity.NewTaskQuery()
+	query.Statuses = []entity.StatusName{entity.Todo}
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"c", "d"}, taskNames(page.Tasks))
+
+	// test priority filter
+	query = entity.NewTaskQuery()
+	query.Priorities = []entity.Priority{entity.PriorityUrgent, entity.PriorityHigh}
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"c", "b", "a"}, taskNames(page.Tasks))
+
+	// test deadline range
+	query = entity.NewTaskQuery()
+	query.DeadlineFrom = &deadline2
+	query.DeadlineTo = &deadline3
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"c", "b"}, taskNames(page.Tasks))
+
+	// test keyset pagination sorted by deadline, nulls last
+	query = entity.NewTaskQuery()
+	query.SortBy = entity.SortByDeadline
+	query.Order = entity.Desc
+	query.Limit = 2
+	names := []string{}
+	for {
+		page, err = suite.tr.GetAll(user.ID, query)
+		suite.Assert().Nil(err)
+		names = append(names, taskNames(page.Tasks)...)
+		if page.NextCursor == nil {
+			break
+		}
+		query.Cursor = *page.NextCursor
+	}
+	suite.Assert().Equal([]string{"b", "c", "a", "d"}, names)
+
+	// test cursor for a different sort field
+	query.SortBy = entity.SortByName
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(page)
+	suite.Assert().ErrorIs(err, entity.ErrInvalidCursor)
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryGetAllExcludesSnoozed() {
+	user, err := suite.ur.Create(&entity.User{Email: "snooze@test.com"})
+	suite.Assert().Nil(err)
+
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+	for _, task := range []*entity.Task{
+		{Name: "active", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "started", Status: entity.Status{Name: entity.Todo}, UserID: user.ID, StartAt: &past},
+		{Name: "not started", Status: entity.Status{Name: entity.Todo}, UserID: user.ID, StartAt: &future},
+	} {
+		_, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+	}
+	snoozed, err := suite.tr.Create(&entity.Task{Name: "snoozed", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+
+	// test snoozed tasks and tasks before their start date are hidden by default
+	snoozed, err = suite.tr.Snooze(snoozed.ID, user.ID, &future)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(future, *snoozed.SnoozedUntil)
+	page, err := suite.tr.GetAll(user.ID, entity.NewTaskQuery())
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"active", "started"}, taskNames(page.Tasks))
+
+	query := entity.NewTaskQuery()
+	query.IncludeSnoozed = true
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"active", "started", "not started", "snoozed"}, taskNames(page.Tasks))
+
+	// test the task resurfaces once the snooze has passed
+	_, err = suite.tr.Snooze(snoozed.ID, user.ID, &past)
+	suite.Assert().Nil(err)
+	page, err = suite.tr.GetAll(user.ID, entity.NewTaskQuery())
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"active", "started", "snoozed"}, taskNames(page.Tasks))
+
+	// test nil wakes the task up
+	snoozed, err = suite.tr.Snooze(snoozed.ID, user.ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(snoozed.SnoozedUntil)
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryGetSmartList() {
+	user, err := suite.ur.Create(&entity.User{Email: "smartlist@test.com"})
+	suite.Assert().Nil(err)
+
+	// 2025-03-10 10:00 in Tokyo
+	now := time.Date(2025, 3, 10, 1, 0, 0, 0, time.UTC)
+	yesterday := pkg.Str2time("2025-03-09")
+	today := pkg.Str2time("2025-03-10")
+	nextWeek := pkg.Str2time("2025-03-17")
+	later := pkg.Str2time("2025-03-18")
+	future := time.Now().Add(time.Hour)
+	for _, task := range []*entity.Task{
+		{Name: "yesterday", Status: entity.Status{Name: entity.Todo}, UserID: user.ID, Deadline: &yesterday},
+		{Name: "today", Status: entity.Status{Name: entity.InProgress}, UserID: user.ID, Deadline: &today},
+		{Name: "done today", Status: entity.Status{Name: entity.Done}, UserID: user.ID, Deadline: &today},
+		{Name: "archived", Status: entity.Status{Name: entity.Archive}, UserID: user.ID, Deadline: &yesterday},
+		{Name: "next week", Status: entity.Status{Name: entity.Todo}, UserID: user.ID, Deadline: &nextWeek},
+		{Name: "later", Status: entity.Status{Name: entity.Pending}, UserID: user.ID, Deadline: &later},
+		{Name: "no deadline", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "snoozed", Status: entity.Status{Name: entity.Todo}, UserID: user.ID, StartAt: &future},
+	} {
+		_, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+	}
+	for _, dueTime := range []string{"09:00", "18:00"} {
+		task := &entity.Task{Name: "today " + dueTime, Status: entity.Status{Name: entity.Todo}, UserID: user.ID}
+		suite.Assert().Nil(task.SetDeadline(&today, dueTime, "Asia/Tokyo"))
+		_, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+	}
+
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	smartList := func(list entity.SmartList, location *time.Location) []string {
+		query, err := entity.NewSmartListQuery(list, 0, location, now)
+		suite.Require().Nil(err)
+		tasks, err := suite.tr.GetSmartList(user.ID, query)
+		suite.Require().Nil(err)
+		return taskNames(*tasks)
+	}
+
+	// test done and archived tasks are excluded and a passed due time is overdue
+	suite.Assert().Equal([]string{"today 18:00", "today"}, smartList(entity.SmartListToday, tokyo))
+	suite.Assert().Equal([]string{"yesterday", "today 09:00"}, smartList(entity.SmartListOverdue, tokyo))
+	suite.Assert().Equal([]string{"next week"}, smartList(entity.SmartListUpcoming, tokyo))
+	suite.Assert().Equal([]string{"no deadline"}, smartList(entity.SmartListSomeday, tokyo))
+
+	// test the day depends on the time zone
+	newYork, _ := time.LoadLocation("America/New_York")
+	suite.Assert().Equal([]string{"yesterday"}, smartList(entity.SmartListToday, newYork))
+	suite.Assert().Equal([]string{"today 09:00"}, smartList(entity.SmartListOverdue, newYork))
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositorySearch() {
+	user, err := suite.ur.Create(&entity.User{Email: "search@test.com"})
+	suite.Assert().Nil(err)
+	work := &entity.Tag{Name: "Work", UserID: user.ID}
+	suite.Require().Nil(suite.DB.Create(work).Error)
+	project := &entity.Project{Name: "Side Project", UserID: user.ID}
+	suite.Require().Nil(suite.DB.Create(project).Error)
+
+	january := pkg.Str2time("2025-01-20")
+	february := pkg.Str2time("2025-02-01")
+	for _, task := range []*entity.Task{
+		{Name: "Quarterly report", Status: entity.Status{Name: entity.InProgress}, UserID: user.ID, Deadline: &january, Tags: []entity.Tag{*work}},
+		{Name: "Draft", Description: "for the quarterly report", Status: entity.Status{Name: entity.Todo}, UserID: user.ID, Deadline: &february, ProjectID: &project.ID},
+		{Name: "100% done", Status: entity.Status{Name: entity.Done}, UserID: user.ID, Priority: entity.PriorityHigh},
+	} {
+		_, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+	}
+
+	search := func(q string) []string {
+		parsed, err := entity.ParseTaskSearch(q)
+		suite.Require().Nil(err)
+		query := entity.NewTaskQuery()
+		query.Search = parsed
+		page, err := suite.tr.GetAll(user.ID, query)
+		suite.Require().Nil(err)
+		return taskNames(page.Tasks)
+	}
+
+	// test text matches the name or the description regardless of case
+	suite.Assert().Equal([]string{"Draft", "Quarterly report"}, search(`"QUARTERLY REPORT"`))
+	// test conditions are combined
+	suite.Assert().Equal([]string{"Quarterly report"}, search(`status:inProgress due:<2025-02-01 tag:work "quarterly report"`))
+	suite.Assert().Equal([]string{"Draft"}, search(`due:>=2025-02-01 project:"side project"`))
+	suite.Assert().Equal([]string{"100% done"}, search(`due:none priority:high`))
+	// test negation keeps tasks without a project or a deadline
+	suite.Assert().Equal([]string{"Quarterly report", "100% done"}, search(`-project:"Side Project"`))
+	suite.Assert().Equal([]string{"Draft", "100% done"}, search(`-due:2025-01-20`))
+	// test LIKE wildcards are matched literally
+	suite.Assert().Equal([]string{"100% done"}, search(`%`))
+	suite.Assert().Equal([]string{"Draft", "Quarterly report", "100% done"}, search(`created:>=2025-01-01`))
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryFullTextSearch() {
+	user, err := suite.ur.Create(&entity.User{Email: "fulltext@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "fulltext-other@test.com"})
+	suite.Assert().Nil(err)
+
+	for _, task := range []*entity.Task{
+		{Name: "Draft", Description: "Quarterly report for <finance>", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "Quarterly report", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "Report archive", Description: "old quarterly numbers", Status: entity.Status{Name: entity.Done}, UserID: user.ID},
+		{Name: "Weekly report", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "Quarterly report", Status: entity.Status{Name: entity.Todo}, UserID: other.ID},
+	} {
+		_, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+	}
+
+	search := func(text string, cursor string, limit int) *entity.TaskPage {
+		query := entity.NewTaskQuery()
+		query.Text = text
+		query.SortBy = entity.SortByRelevance
+		query.Order = entity.Desc
+		query.Cursor = cursor
+		query.Limit = limit
+		page, err := suite.tr.GetAll(user.ID, query)
+		suite.Require().Nil(err)
+		return page
+	}
+
+	// test only the user's tasks containing every word are returned, matches in the name first
+	page := search("QUARTERLY report", "", 10)
+	suite.Assert().Equal([]string{"Quarterly report", "Report archive", "Draft"}, taskNames(page.Tasks))
+	suite.Assert().Equal("<mark>Quarterly</mark> <mark>report</mark>", page.Tasks[0].HighlightedName)
+	suite.Assert().Equal("Draft", page.Tasks[2].HighlightedName)
+	// test highlights are HTML-escaped
+	suite.Assert().Equal("<mark>Quarterly</mark> <mark>report</mark> for &lt;finance&gt;", page.Tasks[2].HighlightedDescription)
+
+	// test pages follow the relevance order
+	first := search("quarterly report", "", 2)
+	suite.Assert().Equal([]string{"Quarterly report", "Report archive"}, taskNames(first.Tasks))
+	suite.Require().NotNil(first.NextCursor)
+	second := search("quarterly report", *first.NextCursor, 2)
+	suite.Assert().Equal([]string{"Draft"}, taskNames(second.Tasks))
+	suite.Assert().Nil(second.NextCursor)
+
+	// test other sort orders and filters can be combined with text
+	query := entity.NewTaskQuery()
+	query.Text = "report"
+	query.Statuses = []entity.StatusName{entity.Todo}
+	query.SortBy = entity.SortByName
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"Draft", "Quarterly report", "Weekly report"}, taskNames(page.Tasks))
+
+	// test renamed tasks are searched by their new name
+	weekly := page.Tasks[2]
+	weekly.Name = "Monthly summary"
+	_, err = suite.tr.Save(&weekly, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(search("weekly", "", 10).Tasks)
+	suite.Assert().Equal([]string{"Monthly summary"}, taskNames(search("monthly", "", 10).Tasks))
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryGetAllByPriority() {
+	user, err := suite.ur.Create(&entity.User{Email: "priority@test.com"})
+	suite.Assert().Nil(err)
+
+	for _, task := range []*entity.Task{
+		{Name: "medium", Priority: entity.PriorityMedium, Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "none", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "urgent", Priority: entity.PriorityUrgent, Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "low", Priority: entity.PriorityLow, Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+	} {
+		_, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+	}
+
+	query := entity.NewTaskQuery()
+	query.SortBy = entity.SortByPriority
+	query.Order = entity.Desc
+	query.Limit = 3
+	page, err := suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"urgent", "medium", "low"}, taskNames(page.Tasks))
+	suite.Assert().NotNil(page.NextCursor)
+
+	query.Cursor = *page.NextCursor
+	page, err = suite.tr.GetAll(user.ID, query)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"none"}, taskNames(page.Tasks))
+	suite.Assert().Equal(entity.PriorityNone, page.Tasks[0].Priority)
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryMove() {
+	user, err := suite.ur.Create(&entity.User{Email: "move@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-move@test.com"})
+	suite.Assert().Nil(err)
+
+	tasks := map[string]*entity.Task{}
+	for _, task := range []*entity.Task{
+		{Name: "a", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "b", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "c", Status: entity.Status{Name: entity.Todo}, UserID: user.ID},
+		{Name: "x", Status: entity.Status{Name: entity.InProgress}, UserID: user.ID},
+	} {
+		createdTask, err := suite.tr.Create(task)
+		suite.Assert().Nil(err)
+		tasks[task.Name] = createdTask
+	}
+	foreign, err := suite.tr.Create(&entity.Task{Name: "foreign", Status: entity.Status{Name: entity.Todo}, UserID: other.ID})
+	suite.Assert().Nil(err)
+
+	order := func() []string {
+		page, err := suite.tr.GetAll(user.ID, entity.NewTaskQuery())
+		suite.Assert().Nil(err)
+		return taskNames(page.Tasks)
+	}
+	suite.Assert().Equal([]string{"a", "b", "c", "x"}, order())
+
+	// test move between both neighbours
+	movedTask, err := suite.tr.Move(tasks["c"].ID, user.ID, entity.Todo, &tasks["a"].ID, &tasks["b"].ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.Todo, movedTask.Status.Name)
+	suite.Assert().Equal([]string{"a", "c", "b", "x"}, order())
+
+	// test move to the top with only the next neighbour
+	_, err = suite.tr.Move(tasks["b"].ID, user.ID, entity.Todo, nil, &tasks["a"].ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"b", "a", "c", "x"}, order())
+
+	// test move to another column with only the previous neighbour
+	movedTask, err = suite.tr.Move(tasks["a"].ID, user.ID, entity.InProgress, &tasks["x"].ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.InProgress, movedTask.Status.Name)
+	suite.Assert().Equal([]string{"b", "c", "x", "a"}, order())
+
+	// test move to the end of a column without neighbours
+	_, err = suite.tr.Move(tasks["b"].ID, user.ID, entity.InProgress, nil, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"c", "x", "a", "b"}, order())
+
+	// test neighbours must be in the target column and owned by the user
+	_, err = suite.tr.Move(tasks["c"].ID, user.ID, entity.Todo, &tasks["x"].ID, nil)
+	suite.Assert().ErrorIs(err, entity.ErrInvalidMove)
+	_, err = suite.tr.Move(tasks["c"].ID, user.ID, entity.Todo, &foreign.ID, nil)
+	suite.Assert().ErrorIs(err, entity.ErrInvalidMove)
+	_, err = suite.tr.Move(tasks["x"].ID, user.ID, entity.InProgress, &tasks["b"].ID, &tasks["a"].ID)
+	suite.Assert().ErrorIs(err, entity.ErrInvalidMove)
+	suite.Assert().Equal([]string{"c", "x", "a", "b"}, order())
+
+	// test changing the status appends the task to the new column
+	_, err = suite.tr.Save(&entity.Task{ID: tasks["c"].ID, UserID: user.ID, Status: entity.Status{Name: entity.InProgress}}, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"x", "a", "b", "c"}, order())
+
+	// test tasks without a rank fall back to id order and are rebalanced when a task is moved between them
+	suite.Assert().Nil(suite.DB.Model(&entity.Task{}).Where("user_id = ?", user.ID).Update("rank", "").Error)
+	suite.Assert().Equal([]string{"a", "b", "c", "x"}, order())
+	_, err = suite.tr.Move(tasks["x"].ID, user.ID, entity.InProgress, &tasks["a"].ID, &tasks["b"].ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"a", "x", "b", "c"}, order())
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryTrash() {
+	user, err := suite.ur.Create(&entity.User{Email: "trash@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-trash@test.com"})
+	suite.Assert().Nil(err)
+
+	blocker, err := suite.tr.Create(&entity.Task{Name: "blocker", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	blocked, err := suite.tr.Create(&entity.Task{Name: "blocked", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(suite.DB.Create(&entity.TaskDependency{TaskID: blocked.ID, BlockerID: blocker.ID}).Error)
+
+	// test delete moves the task to the trash
+	suite.Assert().Nil(suite.tr.Delete(blocker.ID, user.ID))
+	_, err = suite.tr.Get(blocker.ID, user.ID)
+	suite.Assert().NotNil(err)
+	page, err := suite.tr.GetAll(user.ID, entity.NewTaskQuery())
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"blocked"}, taskNames(page.Tasks))
+	trash, err := suite.tr.GetTrash(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"blocker"}, taskNames(*trash))
+	suite.Assert().True((*trash)[0].DeletedAt.Valid)
+	trash, err = suite.tr.GetTrash(other.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*trash)
+
+	// test a trashed task does not block
+	getTask, err := suite.tr.Get(blocked.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(getTask.Blockers)
+	suite.Assert().False(getTask.IsBlocked())
+
+	// test restore
+	_, err = suite.tr.Restore(blocker.ID, other.ID)
+	suite.Assert().NotNil(err)
+	restoredTask, err := suite.tr.Restore(blocker.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().False(restoredTask.DeletedAt.Valid)
+	getTask, err = suite.tr.Get(blocked.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().True(getTask.IsBlocked())
+	_, err = suite.tr.Restore(blocker.ID, user.ID)
+	suite.Assert().NotNil(err)
+
+	// test permanent delete only applies to the trash
+	suite.Assert().Nil(suite.tr.DeletePermanently(blocker.ID, user.ID))
+	_, err = suite.tr.Get(blocker.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(suite.tr.Delete(blocker.ID, user.ID))
+	suite.Assert().Nil(suite.tr.DeletePermanently(blocker.ID, other.ID))
+	trash, err = suite.tr.GetTrash(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*trash, 1)
+	suite.Assert().Nil(suite.tr.DeletePermanently(blocker.ID, user.ID))
+	trash, err = suite.tr.GetTrash(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*trash)
+	var count int64
+	suite.Assert().Nil(suite.DB.Model(&entity.TaskDependency{}).Where("blocker_id = ?", blocker.ID).Count(&count).Error)
+	suite.Assert().Zero(count)
+}
+
+func (suite *TaskRepositorySuite) TestTaskRepositoryPurge() {
+	user, err := suite.ur.Create(&entity.User{Email: "purge@test.com"})
+	suite.Assert().Nil(err)
+
+	old, err := suite.tr.Create(&entity.Task{Name: "old", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	recent, err := suite.tr.Create(&entity.Task{Name: "recent", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	_, err = suite.tr.Create(&entity.Task{Name: "active", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(suite.tr.Delete(old.ID, user.ID))
+	suite.Assert().Nil(suite.tr.Delete(recent.ID, user.ID))
+	suite.Assert().Nil(suite.DB.Unscoped().Model(old).Update("deleted_at", time.Now().AddDate(0, 0, -31)).Error)
+
+	purged, err := suite.tr.Purge(time.Now().AddDate(0, 0, -30))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(1), purged)
+	trash, err := suite.tr.GetTrash(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"recent"}, taskNames(*trash))
+	page, err := suite.tr.GetAll(user.ID, entity.NewTaskQuery())
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"active"}, taskNames(page.Tasks))
+}
+
+func taskNames(tasks []entity.Task) []string {
+	names := make([]string, len(tasks))
+	for i, task := range tasks {
+		names[i] = task.Name
+	}
+	return names
+}
@@ -0,0 +1,126 @@
+package gateway
+
+import (
+	"backend/entity"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type ITimeEntryRepository interface {
+	Create(entry *entity.TimeEntry) (*entity.TimeEntry, error)
+	Get(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) (*entity.TimeEntry, error)
+	GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.TimeEntry, error)
+	GetRunning(userID entity.UserID) (*entity.TimeEntry, error)
+	Save(entry *entity.TimeEntry) (*entity.TimeEntry, error)
+	Delete(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) error
+}
+
+type timeEntryRepository struct {
+	db *gorm.DB
+}
+
+func NewTimeEntryRepository(db *gorm.DB) ITimeEntryRepository {
+	return &timeEntryRepository{db: db}
+}
+
+// ownedTask は指定ユーザーが所有するタスクの作業時間に絞り込む条件
+func (ter *timeEntryRepository) ownedTask(db *gorm.DB, taskID entity.TaskID, userID entity.UserID) *gorm.DB {
+	return db.Where("task_id = ? AND user_id = ? AND task_id IN (?)", taskID, userID,
+		ter.db.Model(&entity.Task{}).Select("id").Where("id = ? AND user_id = ?", taskID, userID))
+}
+
+// Create は作業時間を記録する。タスクが無いか他人のものなら ErrTaskNotFound、
+// 計測中の記録を作成する場合、既にタイマーが動いていれば ErrTimerAlreadyRunning
+func (ter *timeEntryRepository) Create(entry *entity.TimeEntry) (*entity.TimeEntry, error) {
+	var task entity.Task
+	if err := ter.db.Select("id").Where("id = ? AND user_id = ?", entry.TaskID, entry.UserID).First(&task).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrTaskNotFound
+		}
+		return nil, err
+	}
+	// 動いているタイマーは idx_time_entries_running で 1 ユーザー 1 つに制限しているので、同時に開始されても 1 つだけが成功する
+	if err := ter.db.Create(entry).Error; err != nil {
+		if entry.IsRunning() && isDuplicatedKey(ter.db, err) {
+			return nil, entity.ErrTimerAlreadyRunning
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// isDuplicatedKey は err が一意制約の違反かどうかを返す
+func isDuplicatedKey(db *gorm.DB, err error) bool {
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
+func (ter *timeEntryRepository) Get(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) (*entity.TimeEntry, error) {
+	var entry = entity.TimeEntry{}
+	if err := ter.ownedTask(ter.db, taskID, userID).Where("id = ?", entryID).First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrTimeEntryNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (ter *timeEntryRepository) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.TimeEntry, error) {
+	entries := []entity.TimeEntry{}
+	if err := ter.ownedTask(ter.db, taskID, userID).Order("started_at").Order("id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return &entries, nil
+}
+
+// GetRunning は計測中のタイマーを返す。動いていなければ ErrTimerNotRunning
+func (ter *timeEntryRepository) GetRunning(userID entity.UserID) (*entity.TimeEntry, error) {
+	var entry = entity.TimeEntry{}
+	if err := ter.db.Where("user_id = ? AND ended_at IS NULL", userID).First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrTimerNotRunning
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Save は作業時間の開始・終了日時とメモを更新する
+func (ter *timeEntryRepository) Save(entry *entity.TimeEntry) (*entity.TimeEntry, error) {
+	selectedEntry, err := ter.Get(entry.ID, entry.TaskID, entry.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ter.db.Model(selectedEntry).Updates(map[string]any{
+		"started_at":       entry.StartedAt,
+		"ended_at":         entry.EndedAt,
+		"duration_seconds": entry.DurationSeconds,
+		"note":             entry.Note,
+	}).Error; err != nil {
+		return nil, err
+	}
+	selectedEntry.StartedAt = entry.StartedAt
+	selectedEntry.EndedAt = entry.EndedAt
+	selectedEntry.DurationSeconds = entry.DurationSeconds
+	selectedEntry.Note = entry.Note
+
+	return selectedEntry, nil
+}
+
+// Delete は作業時間を削除する。無いか他人のものなら ErrTimeEntryNotFound
+func (ter *timeEntryRepository) Delete(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) error {
+	var entry = entity.TimeEntry{}
+	result := ter.ownedTask(ter.db, taskID, userID).Where("id = ?", entryID).Delete(&entry)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrTimeEntryNotFound
+	}
+	return nil
+}
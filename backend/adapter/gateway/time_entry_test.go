@@ -0,0 +1,110 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/tester"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeEntryRepositorySuite struct {
+	tester.DBSQLiteSuite
+	ter gateway.ITimeEntryRepository
+	tr  gateway.ITaskRepository
+	ur  gateway.IUserRepository
+}
+
+func TestTimeEntryRepositorySuite(t *testing.T) {
+	suite.Run(t, new(TimeEntryRepositorySuite))
+}
+
+func (suite *TimeEntryRepositorySuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.ter = gateway.NewTimeEntryRepository(suite.DB)
+	suite.tr = gateway.NewTaskRepository(suite.DB)
+	suite.ur = gateway.NewUserRepository(suite.DB)
+}
+
+func (suite *TimeEntryRepositorySuite) TestTimeEntryRepository() {
+	user, err := suite.ur.Create(&entity.User{Email: "time@test.com"})
+	suite.Assert().Nil(err)
+	other, err := suite.ur.Create(&entity.User{Email: "other-time@test.com"})
+	suite.Assert().Nil(err)
+	task, err := suite.tr.Create(&entity.Task{Name: "billing", Status: entity.Status{Name: entity.Todo}, UserID: user.ID})
+	suite.Assert().Nil(err)
+	startedAt := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+
+	// test create a manual entry
+	manual := &entity.TimeEntry{TaskID: task.ID, UserID: user.ID, StartedAt: startedAt, Note: "meeting"}
+	suite.Assert().Nil(manual.Finish(startedAt.Add(90 * time.Minute)))
+	manual, err = suite.ter.Create(manual)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(5400), manual.DurationSeconds)
+
+	// test another user's task is rejected
+	_, err = suite.ter.Create(&entity.TimeEntry{TaskID: task.ID, UserID: other.ID, StartedAt: startedAt})
+	suite.Assert().ErrorIs(err, entity.ErrTaskNotFound)
+
+	// test only one timer runs per user
+	_, err = suite.ter.GetRunning(user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrTimerNotRunning)
+	running, err := suite.ter.Create(&entity.TimeEntry{TaskID: task.ID, UserID: user.ID, StartedAt: startedAt.Add(2 * time.Hour)})
+	suite.Assert().Nil(err)
+	_, err = suite.ter.Create(&entity.TimeEntry{TaskID: task.ID, UserID: user.ID, StartedAt: startedAt.Add(3 * time.Hour)})
+	suite.Assert().ErrorIs(err, entity.ErrTimerAlreadyRunning)
+	suite.Assert().NotNil(suite.DB.Create(&entity.TimeEntry{TaskID: task.ID, UserID: user.ID, StartedAt: startedAt}).Error)
+	getRunning, err := suite.ter.GetRunning(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(running.ID, getRunning.ID)
+
+	// test running timers are not counted in the task total
+	getTask, err := suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(5400), getTask.TrackedSeconds)
+
+	// test save stops the timer
+	suite.Assert().Nil(running.Finish(running.StartedAt.Add(30 * time.Minute)))
+	running.Note = "review"
+	savedEntry, err := suite.ter.Save(running)
+	suite.Assert().Nil(err)
+	suite.Assert().False(savedEntry.IsRunning())
+	suite.Assert().Equal("review", savedEntry.Note)
+	_, err = suite.ter.GetRunning(user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrTimerNotRunning)
+
+	getTask, err = suite.tr.Get(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(7200), getTask.TrackedSeconds)
+
+	// test get all in chronological order
+	entries, err := suite.ter.GetAll(task.ID, user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(*entries, 2)
+	suite.Assert().Equal(manual.ID, (*entries)[0].ID)
+	entries, err = suite.ter.GetAll(task.ID, other.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(*entries)
+
+	// test another user cannot get or delete the entry
+	_, err = suite.ter.Get(manual.ID, task.ID, other.ID)
+	suite.Assert().ErrorIs(err, entity.ErrTimeEntryNotFound)
+	suite.Assert().ErrorIs(suite.ter.Delete(manual.ID, task.ID, other.ID), entity.ErrTimeEntryNotFound)
+	_, err = suite.ter.Get(manual.ID, task.ID, user.ID)
+	suite.Assert().Nil(err)
+
+	// test delete
+	suite.Assert().Nil(suite.ter.Delete(manual.ID, task.ID, user.ID))
+	_, err = suite.ter.Get(manual.ID, task.ID, user.ID)
+	suite.Assert().ErrorIs(err, entity.ErrTimeEntryNotFound)
+	suite.Assert().ErrorIs(suite.ter.Delete(manual.ID, task.ID, user.ID), entity.ErrTimeEntryNotFound)
+
+	// test removal on permanent delete
+	suite.Assert().Nil(suite.tr.Delete(task.ID, user.ID))
+	suite.Assert().Nil(suite.tr.DeletePermanently(task.ID, user.ID))
+	var count int64
+	suite.Assert().Nil(suite.DB.Model(&entity.TimeEntry{}).Where("task_id = ?", task.ID).Count(&count).Error)
+	suite.Assert().Zero(count)
+}
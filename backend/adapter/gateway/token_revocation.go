@@ -0,0 +1,45 @@
+package gateway
+
+import (
+	"backend/entity"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ITokenRevocationStore は有効期限前に無効にしたアクセストークンを jti で管理するストア
+type ITokenRevocationStore interface {
+	// Revoke は jti のトークンを expiresAt まで無効にする。既に無効なトークンでもエラーにしない
+	Revoke(jti string, expiresAt time.Time) error
+	IsRevoked(jti string) (bool, error)
+	// Prune は now までに期限が切れた記録を削除し、削除した件数を返す
+	Prune(now time.Time) (int64, error)
+}
+
+type tokenRevocationRepository struct {
+	db *gorm.DB
+}
+
+// NewTokenRevocationRepository はデータベースに保存するストアを返す。複数のサーバーで共有できる
+func NewTokenRevocationRepository(db *gorm.DB) ITokenRevocationStore {
+	return &tokenRevocationRepository{db: db}
+}
+
+func (tr *tokenRevocationRepository) Revoke(jti string, expiresAt time.Time) error {
+	return tr.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&entity.RevokedToken{JTI: jti, ExpiresAt: expiresAt}).Error
+}
+
+func (tr *tokenRevocationRepository) IsRevoked(jti string) (bool, error) {
+	var count int64
+	if err := tr.db.Model(&entity.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (tr *tokenRevocationRepository) Prune(now time.Time) (int64, error) {
+	result := tr.db.Where("expires_at <= ?", now).Delete(&entity.RevokedToken{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,45 @@
+package gateway
+
+import (
+	"sync"
+	"time"
+)
+
+type memoryTokenRevocationStore struct {
+	mu      sync.RWMutex
+	revoked map[string]time.Time
+}
+
+// NewMemoryTokenRevocationStore はプロセス内に保持するストアを返す。再起動で消えるのでテスト用
+func NewMemoryTokenRevocationStore() ITokenRevocationStore {
+	return &memoryTokenRevocationStore{revoked: map[string]time.Time{}}
+}
+
+func (ms *memoryTokenRevocationStore) Revoke(jti string, expiresAt time.Time) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if _, exists := ms.revoked[jti]; !exists {
+		ms.revoked[jti] = expiresAt
+	}
+	return nil
+}
+
+func (ms *memoryTokenRevocationStore) IsRevoked(jti string) (bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	_, exists := ms.revoked[jti]
+	return exists, nil
+}
+
+func (ms *memoryTokenRevocationStore) Prune(now time.Time) (int64, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	var pruned int64
+	for jti, expiresAt := range ms.revoked {
+		if !expiresAt.After(now) {
+			delete(ms.revoked, jti)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
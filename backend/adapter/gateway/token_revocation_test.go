@@ -0,0 +1,53 @@
+package gateway_test
+
+import (
+	"backend/adapter/gateway"
+	"backend/pkg/tester"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenRevocationSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestTokenRevocationSuite(t *testing.T) {
+	suite.Run(t, new(TokenRevocationSuite))
+}
+
+// assertTokenRevocationStore は実装によらずストアが満たすべき振る舞いを確認する
+func (suite *TokenRevocationSuite) assertTokenRevocationStore(store gateway.ITokenRevocationStore) {
+	now := time.Now().UTC()
+
+	// test revoked tokens are reported and revoke is idempotent
+	suite.Assert().Nil(store.Revoke("expiring", now.Add(time.Minute)))
+	suite.Assert().Nil(store.Revoke("expiring", now.Add(time.Minute)))
+	suite.Assert().Nil(store.Revoke("lasting", now.Add(time.Hour)))
+	revoked, err := store.IsRevoked("expiring")
+	suite.Assert().Nil(err)
+	suite.Assert().True(revoked)
+	revoked, err = store.IsRevoked("unknown")
+	suite.Assert().Nil(err)
+	suite.Assert().False(revoked)
+
+	// test prune removes only the records of expired tokens
+	pruned, err := store.Prune(now.Add(time.Minute))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(1), pruned)
+	revoked, err = store.IsRevoked("expiring")
+	suite.Assert().Nil(err)
+	suite.Assert().False(revoked)
+	revoked, err = store.IsRevoked("lasting")
+	suite.Assert().Nil(err)
+	suite.Assert().True(revoked)
+}
+
+func (suite *TokenRevocationSuite) TestTokenRevocationRepository() {
+	suite.assertTokenRevocationStore(gateway.NewTokenRevocationRepository(suite.DB))
+}
+
+func (suite *TokenRevocationSuite) TestMemoryTokenRevocationStore() {
+	suite.assertTokenRevocationStore(gateway.NewMemoryTokenRevocationStore())
+}
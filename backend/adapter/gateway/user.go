@@ -2,6 +2,8 @@ package gateway
 
 import (
 	"backend/entity"
+	"errors"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"gorm.io/gorm"
@@ -13,6 +15,9 @@ type IUserRepository interface {
 	GetByEmail(email string) (*entity.User, error)
 	Save(user *entity.User) (*entity.User, error)
 	Delete(userID entity.UserID) error
+	VerifyEmail(userID entity.UserID, now time.Time) error
+	ClaimVerificationSend(userID entity.UserID, since time.Time, now time.Time) error
+	SetVerificationSentAt(userID entity.UserID, sentAt *time.Time) error
 }
 
 type userRepository struct {
@@ -41,6 +46,9 @@ func (ur *userRepository) Get(userID entity.UserID) (*entity.User, error) {
 func (ur *userRepository) GetByEmail(email string) (*entity.User, error) {
 	var user = entity.User{}
 	if err := ur.db.Where("email = ?", email).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrUserNotFound
+		}
 		return nil, err
 	}
 	return &user, nil
@@ -69,3 +77,29 @@ func (ur *userRepository) Delete(userID entity.UserID) error {
 	}
 	return nil
 }
+
+// VerifyEmail はメールアドレスを確認済みにする。既に確認済みなら日時は変えない
+func (ur *userRepository) VerifyEmail(userID entity.UserID, now time.Time) error {
+	return ur.db.Model(&entity.User{}).Where("id = ? AND email_verified_at IS NULL", userID).
+		Update("email_verified_at", now).Error
+}
+
+// ClaimVerificationSend は確認メールを送る権利を取る。since より後に送っていれば ErrEmailVerificationThrottled を返す。
+// 同時に呼ばれても 1 つだけが成功する
+func (ur *userRepository) ClaimVerificationSend(userID entity.UserID, since time.Time, now time.Time) error {
+	result := ur.db.Model(&entity.User{}).
+		Where("id = ? AND (verification_sent_at IS NULL OR verification_sent_at <= ?)", userID, since).
+		Update("verification_sent_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity.ErrEmailVerificationThrottled
+	}
+	return nil
+}
+
+// SetVerificationSentAt は確認メールを送った時刻を sentAt にする。nil なら送っていないことにする
+func (ur *userRepository) SetVerificationSentAt(userID entity.UserID, sentAt *time.Time) error {
+	return ur.db.Model(&entity.User{}).Where("id = ?", userID).Update("verification_sent_at", sentAt).Error
+}
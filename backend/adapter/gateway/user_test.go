@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/suite"
@@ -75,8 +76,8 @@ func (suite *UserRepositorySuite) TestUserRepositoryCRUD() {
 func (suite *UserRepositorySuite) TestUserCreateFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
-	mockDB.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("email","password","created_at") VALUES ($1,$2,$3) RETURNING "id"`)).
-		WithArgs("test@test.com", "", sqlmock.AnyArg()).
+	mockDB.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("email","password","time_zone","created_at","email_verified_at","verification_sent_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs("test@test.com", "", "", sqlmock.AnyArg(), nil, nil).
 		WillReturnError(errors.New("create error"))
 	mockDB.ExpectRollback()
 
@@ -100,6 +101,30 @@ func (suite *UserRepositorySuite) TestUserGetFailure() {
 	suite.Assert().Equal("get error", err.Error())
 }
 
+func (suite *UserRepositorySuite) TestUserEmailVerification() {
+	user, err := suite.ur.Create(&entity.User{Email: "verify@test.com"})
+	suite.Require().Nil(err)
+	now := time.Now().UTC()
+
+	// test the first send is allowed and a resend within the interval is throttled
+	suite.Assert().Nil(suite.ur.ClaimVerificationSend(user.ID, now.Add(-time.Minute), now))
+	suite.Assert().ErrorIs(suite.ur.ClaimVerificationSend(user.ID, now.Add(-time.Minute), now.Add(time.Second)), entity.ErrEmailVerificationThrottled)
+	later := now.Add(2 * time.Minute)
+	suite.Assert().Nil(suite.ur.ClaimVerificationSend(user.ID, later.Add(-time.Minute), later))
+
+	// test a released claim allows sending again right away
+	suite.Assert().Nil(suite.ur.SetVerificationSentAt(user.ID, nil))
+	suite.Assert().Nil(suite.ur.ClaimVerificationSend(user.ID, later.Add(-time.Minute), later.Add(time.Second)))
+
+	// test verify keeps the first verification time
+	suite.Assert().Nil(suite.ur.VerifyEmail(user.ID, now))
+	suite.Assert().Nil(suite.ur.VerifyEmail(user.ID, later))
+	stored, err := suite.ur.Get(user.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().True(stored.IsEmailVerified())
+	suite.Assert().True(now.Equal(*stored.EmailVerifiedAt))
+}
+
 func (suite *UserRepositorySuite) TestUserGetByEmail() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 ORDER BY "users"."id" LIMIT $2`)).WithArgs("test@test.com", 1).WillReturnError(errors.New("get by email error"))
@@ -110,6 +135,15 @@ func (suite *UserRepositorySuite) TestUserGetByEmail() {
 	suite.Assert().Equal("get by email error", err.Error())
 }
 
+func (suite *UserRepositorySuite) TestUserGetByEmailNotFound() {
+	mockDB := suite.MockDB()
+	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 ORDER BY "users"."id" LIMIT $2`)).WithArgs("unknown@test.com", 1).WillReturnRows(sqlmock.NewRows([]string{"id", "email"}))
+
+	user, err := suite.ur.GetByEmail("unknown@test.com")
+	suite.Assert().Nil(user)
+	suite.Assert().ErrorIs(err, entity.ErrUserNotFound)
+}
+
 func (suite *UserRepositorySuite) TestUserSaveFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT $2`)).WithArgs(1, 1).WillReturnError(errors.New("save error"))
@@ -1,8 +1,10 @@
 package main
 
 import (
-	"backend/entity"
 	"backend/infrastructure/database"
+	"backend/infrastructure/job"
+	"backend/infrastructure/mail"
+	"backend/infrastructure/storage"
 	"backend/infrastructure/web"
 	"backend/pkg"
 	"backend/pkg/logger"
@@ -36,12 +38,36 @@ func main() {
 		logger.Fatal(err.Error())
 	}
 
-	if err := db.AutoMigrate(entity.NewDomains()...); err != nil {
+	if err := database.Migrate(db); err != nil {
 		logger.Fatal("Failed to migrate database: " + err.Error())
 	}
 
+	blobStorage, err := storage.NewStorageFactory(storage.NewConfigStorage())
+	if err != nil {
+		logger.Fatal("Failed to set up storage: " + err.Error())
+	}
+
+	mailConfig := mail.NewConfigMail()
+	mailer, err := mail.NewMailerFactory(mailConfig)
+	if err != nil {
+		logger.Fatal("Failed to set up mailer: " + err.Error())
+	}
+
+	verificationPolicy, err := mail.NewEmailVerificationPolicy()
+	if err != nil {
+		logger.Fatal("Failed to load email verification policy: " + err.Error())
+	}
+
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	jobConfig := job.NewConfigJob()
+	trashPurger := job.NewTrashPurger(db, blobStorage, jobConfig)
+	go trashPurger.Run(jobCtx)
+	tokenRevocationPruner := job.NewTokenRevocationPruner(db, jobConfig)
+	go tokenRevocationPruner.Run(jobCtx)
+
 	config := web.NewConfigWeb()
-	server, err := web.NewGinServer(config.Host, config.Port, config.CorsAllowOrigins, db)
+	server, err := web.NewGinServer(config.Host, config.Port, config.CorsAllowOrigins, db, blobStorage, storage.NewAttachmentPolicy(), mailer, mailConfig.AppURL, verificationPolicy)
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
@@ -55,6 +81,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutdown Server ...")
+	stopJobs()
 	defer logger.Sync()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
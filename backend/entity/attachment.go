@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const DefaultAttachmentFileName = "attachment"
+
+var (
+	ErrAttachmentNotFound       = errors.New("Attachment not found")
+	ErrAttachmentTooLarge       = errors.New("Attachment is too large")
+	ErrAttachmentTypeNotAllowed = errors.New("Attachment type is not allowed")
+)
+
+type AttachmentID int
+
+// Attachment はタスクに添付されたファイル。中身は StorageKey でストレージに保存する
+type Attachment struct {
+	ID          AttachmentID `gorm:"primaryKey"`
+	TaskID      TaskID       `gorm:"not null; index"`
+	UserID      UserID       `gorm:"not null"`
+	FileName    string       `gorm:"not null"`
+	ContentType string       `gorm:"not null"`
+	Size        int64        `gorm:"not null"`
+	StorageKey  string       `gorm:"not null; uniqueIndex"`
+	CreatedAt   time.Time    `gorm:"autoCreateTime"`
+}
+
+// SanitizeFileName はクライアントから送られたファイル名からディレクトリと制御文字を取り除く
+func SanitizeFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == ".." || name == "/" {
+		return DefaultAttachmentFileName
+	}
+	return name
+}
+
+// AttachmentPolicy は添付ファイルのサイズと種類の制限
+type AttachmentPolicy struct {
+	MaxSize      int64
+	AllowedTypes []string
+}
+
+func (p *AttachmentPolicy) Check(contentType string, size int64) error {
+	if size > p.MaxSize {
+		return ErrAttachmentTooLarge
+	}
+	if !slices.Contains(p.AllowedTypes, contentType) {
+		return ErrAttachmentTypeNotAllowed
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeFileName(t *testing.T) {
+	assert.Equal(t, "report.pdf", entity.SanitizeFileName("report.pdf"))
+	assert.Equal(t, "passwd", entity.SanitizeFileName("../../etc/passwd"))
+	assert.Equal(t, "shot.png", entity.SanitizeFileName(`C:\Users\me\shot.png`))
+	assert.Equal(t, "報告書.pdf", entity.SanitizeFileName("報告書.pdf"))
+	assert.Equal(t, "evil.png", entity.SanitizeFileName("evil\r\n.png"))
+	assert.Equal(t, entity.DefaultAttachmentFileName, entity.SanitizeFileName(""))
+	assert.Equal(t, entity.DefaultAttachmentFileName, entity.SanitizeFileName("../"))
+}
+
+func TestAttachmentPolicyCheck(t *testing.T) {
+	policy := entity.AttachmentPolicy{MaxSize: 100, AllowedTypes: []string{"image/png", "application/pdf"}}
+	assert.Nil(t, policy.Check("image/png", 100))
+	assert.ErrorIs(t, policy.Check("image/png", 101), entity.ErrAttachmentTooLarge)
+	assert.ErrorIs(t, policy.Check("text/html", 10), entity.ErrAttachmentTypeNotAllowed)
+}
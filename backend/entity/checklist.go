@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrChecklistOrderMismatch = errors.New("Item IDs must contain every checklist item of the task exactly once")
+
+type ChecklistItemID int
+
+type ChecklistItem struct {
+	ID        ChecklistItemID `gorm:"primaryKey"`
+	TaskID    TaskID          `gorm:"not null; index"`
+	Text      string          `gorm:"not null"`
+	Checked   bool            `gorm:"not null; default:false"`
+	Position  int             `gorm:"not null"`
+	CreatedAt time.Time       `gorm:"autoCreateTime"`
+}
+
+// CompletionPercentage はチェック済み項目の割合を 0〜100 で返す。項目がなければ nil
+func (t *Task) CompletionPercentage() *int {
+	if len(t.ChecklistItems) == 0 {
+		return nil
+	}
+	checked := 0
+	for _, item := range t.ChecklistItems {
+		if item.Checked {
+			checked++
+		}
+	}
+	percentage := checked * 100 / len(t.ChecklistItems)
+	return &percentage
+}
@@ -0,0 +1,33 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecklistItem(t *testing.T) {
+	item := entity.ChecklistItem{
+		ID:       1,
+		TaskID:   1,
+		Text:     "step 1",
+		Checked:  true,
+		Position: 0,
+	}
+	assert.Equal(t, entity.ChecklistItemID(1), item.ID)
+	assert.Equal(t, entity.TaskID(1), item.TaskID)
+	assert.Equal(t, "step 1", item.Text)
+	assert.True(t, item.Checked)
+}
+
+func TestCompletionPercentage(t *testing.T) {
+	task := entity.Task{}
+	assert.Nil(t, task.CompletionPercentage())
+
+	task.ChecklistItems = []entity.ChecklistItem{{Checked: true}, {Checked: false}, {Checked: false}}
+	assert.Equal(t, 33, *task.CompletionPercentage())
+
+	task.ChecklistItems = []entity.ChecklistItem{{Checked: true}, {Checked: true}}
+	assert.Equal(t, 100, *task.CompletionPercentage())
+}
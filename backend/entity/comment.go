@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrCommentNotFound = errors.New("Comment not found")
+
+type CommentID int
+
+// Comment はタスクに対するコメント。Body は Markdown で、EditedAt は編集されていなければ nil
+type Comment struct {
+	ID        CommentID `gorm:"primaryKey"`
+	TaskID    TaskID    `gorm:"not null; index"`
+	UserID    UserID    `gorm:"not null"`
+	User      User      `gorm:"not null; foreignKey:UserID"`
+	Body      string    `gorm:"type:text; not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	EditedAt  *time.Time
+}
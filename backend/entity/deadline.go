@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// DueTimeLayout は期限の時刻 (HH:MM) の形式
+const DueTimeLayout = "15:04"
+
+var (
+	ErrInvalidTimeZone = errors.New("Invalid time zone")
+	ErrInvalidDueTime  = errors.New("Due time must be HH:MM and requires a deadline and a time zone")
+)
+
+// DateOf は t の UTC での日付を 0 時 (UTC) で返す。
+// 終日の期限は日付として扱い、サーバーやデータベースのタイムゾーンで日付がずれないようにする
+func DateOf(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// LoadTimeZone は IANA タイムゾーン名を読み込む。サーバーのタイムゾーンを指す空文字や Local は受け付けない
+func LoadTimeZone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, ErrInvalidTimeZone
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	return location, nil
+}
+
+// SetDeadline は期限日と、任意の時刻 (HH:MM) とタイムゾーンを設定する。
+// 時刻を指定するとタイムゾーンでのその日時を UTC で DueAt に保存し、指定しなければ終日の期限にする
+func (t *Task) SetDeadline(date *time.Time, dueTime string, timeZone string) error {
+	if date == nil {
+		if dueTime != "" || timeZone != "" {
+			return ErrInvalidDueTime
+		}
+		return nil
+	}
+
+	var location *time.Location
+	if timeZone != "" {
+		var err error
+		if location, err = LoadTimeZone(timeZone); err != nil {
+			return err
+		}
+	}
+
+	deadline := DateOf(*date)
+	var dueAt *time.Time
+	if dueTime != "" {
+		if location == nil {
+			return ErrInvalidDueTime
+		}
+		clock, err := time.Parse(DueTimeLayout, dueTime)
+		if err != nil {
+			return ErrInvalidDueTime
+		}
+		instant := time.Date(deadline.Year(), deadline.Month(), deadline.Day(), clock.Hour(), clock.Minute(), 0, 0, location).UTC()
+		dueAt = &instant
+	}
+
+	t.Deadline = &deadline
+	t.DueAt = dueAt
+	t.TimeZone = timeZone
+	return nil
+}
+
+// DueTime は時刻付きの期限の、期限のタイムゾーンでの時刻 (HH:MM) を返す。終日の期限なら空
+func (t *Task) DueTime() string {
+	if t.DueAt == nil {
+		return ""
+	}
+	location, err := LoadTimeZone(t.TimeZone)
+	if err != nil {
+		location = time.UTC
+	}
+	return t.DueAt.In(location).Format(DueTimeLayout)
+}
@@ -0,0 +1,46 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDeadline(t *testing.T) {
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	// test the due time is stored as an instant in UTC
+	task := entity.Task{}
+	assert.Nil(t, task.SetDeadline(&date, "17:00", "Asia/Tokyo"))
+	assert.Equal(t, date, *task.Deadline)
+	assert.Equal(t, time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), *task.DueAt)
+	assert.Equal(t, "Asia/Tokyo", task.TimeZone)
+	assert.Equal(t, "17:00", task.DueTime())
+
+	// test the due time follows daylight saving time of the zone
+	summer := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	assert.Nil(t, task.SetDeadline(&summer, "09:30", "America/New_York"))
+	assert.Equal(t, time.Date(2025, 7, 1, 13, 30, 0, 0, time.UTC), *task.DueAt)
+	assert.Equal(t, "09:30", task.DueTime())
+
+	// test a deadline without a due time is all-day
+	assert.Nil(t, task.SetDeadline(&date, "", ""))
+	assert.Nil(t, task.DueAt)
+	assert.Equal(t, "", task.TimeZone)
+	assert.Equal(t, "", task.DueTime())
+
+	// test invalid combinations are rejected
+	assert.ErrorIs(t, task.SetDeadline(&date, "17:00", ""), entity.ErrInvalidDueTime)
+	assert.ErrorIs(t, task.SetDeadline(&date, "25:00", "UTC"), entity.ErrInvalidDueTime)
+	assert.ErrorIs(t, task.SetDeadline(nil, "17:00", "UTC"), entity.ErrInvalidDueTime)
+	assert.ErrorIs(t, task.SetDeadline(&date, "", "Mars/Olympus"), entity.ErrInvalidTimeZone)
+	assert.ErrorIs(t, task.SetDeadline(&date, "", "Local"), entity.ErrInvalidTimeZone)
+}
+
+func TestDateOf(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	assert.Equal(t, time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC), entity.DateOf(time.Date(2025, 3, 10, 1, 0, 0, 0, jst)))
+	assert.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), entity.DateOf(time.Date(2025, 3, 10, 23, 59, 0, 0, time.UTC)))
+}
@@ -0,0 +1,64 @@
+package entity
+
+import "errors"
+
+var (
+	ErrDependencyCycle        = errors.New("Dependency would create a cycle")
+	ErrDependencyTaskNotFound = errors.New("Dependency task not found")
+	ErrTaskBlocked            = errors.New("Task is blocked by tasks that are not done")
+)
+
+// TaskDependency は TaskID のタスクが BlockerID のタスクの完了を待っていることを表す
+type TaskDependency struct {
+	TaskID    TaskID `gorm:"primaryKey"`
+	BlockerID TaskID `gorm:"primaryKey; index"`
+	Blocker   Task   `gorm:"foreignKey:BlockerID; constraint:OnDelete:CASCADE"`
+}
+
+// CreatesDependencyCycle は graph (タスク ID → そのタスクをブロックしているタスク ID) に
+// taskID が blockerID を待つ依存を追加したときに循環が生じるかを返す
+func CreatesDependencyCycle(graph map[TaskID][]TaskID, taskID TaskID, blockerID TaskID) bool {
+	if taskID == blockerID {
+		return true
+	}
+	visited := map[TaskID]bool{}
+	stack := []TaskID{blockerID}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current == taskID {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		stack = append(stack, graph[current]...)
+	}
+	return false
+}
+
+// BlockedByIDs はこのタスクをブロックしているタスクの ID を返す
+func (t *Task) BlockedByIDs() []TaskID {
+	ids := make([]TaskID, len(t.Blockers))
+	for i, dependency := range t.Blockers {
+		ids[i] = dependency.BlockerID
+	}
+	return ids
+}
+
+// IsBlocked はこのタスクをブロックしているタスクのうち未完了のものがあるかを返す。
+// Blockers.Blocker.Status が読み込まれている必要がある
+func (t *Task) IsBlocked() bool {
+	for _, dependency := range t.Blockers {
+		if dependency.Blocker.Status.Name != Done {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresUnblocked は status への移動に、ブロックしているタスクがすべて完了している必要があるかを返す
+func (s StatusName) RequiresUnblocked() bool {
+	return s == InProgress || s == Done
+}
@@ -0,0 +1,60 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatesDependencyCycle(t *testing.T) {
+	// 3 waits for 2, 2 waits for 1
+	graph := map[entity.TaskID][]entity.TaskID{
+		3: {2},
+		2: {1},
+	}
+	assert.True(t, entity.CreatesDependencyCycle(graph, 1, 1))
+	assert.True(t, entity.CreatesDependencyCycle(graph, 1, 3))
+	assert.True(t, entity.CreatesDependencyCycle(graph, 2, 3))
+	assert.False(t, entity.CreatesDependencyCycle(graph, 3, 1))
+	assert.False(t, entity.CreatesDependencyCycle(graph, 4, 3))
+
+	// diamond: 4 waits for 2 and 3, both wait for 1
+	graph = map[entity.TaskID][]entity.TaskID{
+		4: {2, 3},
+		2: {1},
+		3: {1},
+	}
+	assert.False(t, entity.CreatesDependencyCycle(graph, 5, 4))
+	assert.True(t, entity.CreatesDependencyCycle(graph, 1, 4))
+}
+
+func TestBlockedByIDs(t *testing.T) {
+	task := entity.Task{
+		ID:       3,
+		Blockers: []entity.TaskDependency{{TaskID: 3, BlockerID: 1}, {TaskID: 3, BlockerID: 2}},
+	}
+	assert.Equal(t, []entity.TaskID{1, 2}, task.BlockedByIDs())
+}
+
+func TestIsBlocked(t *testing.T) {
+	task := entity.Task{ID: 3}
+	assert.False(t, task.IsBlocked())
+
+	task.Blockers = []entity.TaskDependency{
+		{TaskID: 3, BlockerID: 1, Blocker: entity.Task{ID: 1, Status: entity.Status{Name: entity.Done}}},
+		{TaskID: 3, BlockerID: 2, Blocker: entity.Task{ID: 2, Status: entity.Status{Name: entity.InProgress}}},
+	}
+	assert.True(t, task.IsBlocked())
+
+	task.Blockers[1].Blocker.Status.Name = entity.Done
+	assert.False(t, task.IsBlocked())
+}
+
+func TestRequiresUnblocked(t *testing.T) {
+	assert.True(t, entity.InProgress.RequiresUnblocked())
+	assert.True(t, entity.Done.RequiresUnblocked())
+	assert.False(t, entity.Todo.RequiresUnblocked())
+	assert.False(t, entity.Pending.RequiresUnblocked())
+	assert.False(t, entity.Archive.RequiresUnblocked())
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const EmailVerificationLifetime = 24 * time.Hour
+
+var (
+	ErrInvalidEmail                  = errors.New("Invalid email address")
+	ErrInvalidEmailVerificationToken = errors.New("Invalid or expired email verification token")
+	ErrEmailAlreadyVerified          = errors.New("Email is already verified")
+	ErrEmailVerificationThrottled    = errors.New("Verification email was sent recently, try again later")
+	ErrEmailNotVerified              = errors.New("Email verification required")
+)
+
+// ValidateEmail は email が表示名などを含まない 1 つのメールアドレスであることを確かめる
+func ValidateEmail(email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Name != "" || address.Address != email {
+		return ErrInvalidEmail
+	}
+	return nil
+}
+
+// UnverifiedRestriction はメールアドレスを確認していないユーザーに対する制限
+type UnverifiedRestriction string
+
+const (
+	UnverifiedAllow    UnverifiedRestriction = "allow"
+	UnverifiedReadOnly UnverifiedRestriction = "read_only"
+	UnverifiedBlock    UnverifiedRestriction = "block"
+)
+
+func (r *UnverifiedRestriction) IsValid() bool {
+	return *r == UnverifiedAllow || *r == UnverifiedReadOnly || *r == UnverifiedBlock
+}
+
+func (r *UnverifiedRestriction) Set(value string) error {
+	newRestriction := UnverifiedRestriction(value)
+	if !newRestriction.IsValid() {
+		return errors.New("Invalid value for UnverifiedRestriction")
+	}
+	*r = newRestriction
+	return nil
+}
+
+// EmailVerificationPolicy は未確認のユーザーへの制限と、確認メールを再送できる間隔
+type EmailVerificationPolicy struct {
+	Restriction    UnverifiedRestriction
+	ResendInterval time.Duration
+}
+
+// Allows は未確認のユーザーに method のリクエストを許すかを返す
+func (p *EmailVerificationPolicy) Allows(method string) bool {
+	switch p.Restriction {
+	case UnverifiedReadOnly:
+		return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+	case UnverifiedBlock:
+		return false
+	default:
+		return true
+	}
+}
+
+// NewEmailVerificationToken は user の今のメールアドレスを確認するためのトークンを secret で署名して返す。
+// トークンは "ユーザー ID.期限.署名" の形で、メールアドレスを変えると使えなくなる
+func NewEmailVerificationToken(secret []byte, user *User, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%d.%d", user.ID, expiresAt.Unix())
+	return payload + "." + emailVerificationSignature(secret, payload, user.Email)
+}
+
+// ParseEmailVerificationToken はトークンのユーザー ID を返す。署名はまだ確かめない
+func ParseEmailVerificationToken(token string) (UserID, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, ErrInvalidEmailVerificationToken
+	}
+	userID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, ErrInvalidEmailVerificationToken
+	}
+	return UserID(userID), nil
+}
+
+// VerifyEmailVerificationToken は token が user の今のメールアドレスに対して署名され、期限が切れていないことを確かめる
+func VerifyEmailVerificationToken(secret []byte, token string, user *User, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != strconv.Itoa(int(user.ID)) {
+		return ErrInvalidEmailVerificationToken
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(emailVerificationSignature(secret, payload, user.Email))) {
+		return ErrInvalidEmailVerificationToken
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || !now.Before(time.Unix(expiresAt, 0)) {
+		return ErrInvalidEmailVerificationToken
+	}
+	return nil
+}
+
+func emailVerificationSignature(secret []byte, payload string, email string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("email-verification:" + payload + ":" + email))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,54 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEmail(t *testing.T) {
+	assert.Nil(t, entity.ValidateEmail("user@test.com"))
+	assert.ErrorIs(t, entity.ValidateEmail("not an email"), entity.ErrInvalidEmail)
+	assert.ErrorIs(t, entity.ValidateEmail("User <user@test.com>"), entity.ErrInvalidEmail)
+	assert.ErrorIs(t, entity.ValidateEmail(" user@test.com"), entity.ErrInvalidEmail)
+	assert.ErrorIs(t, entity.ValidateEmail(""), entity.ErrInvalidEmail)
+}
+
+func TestEmailVerificationPolicyAllows(t *testing.T) {
+	readOnly := entity.EmailVerificationPolicy{Restriction: entity.UnverifiedReadOnly}
+	assert.True(t, readOnly.Allows(http.MethodGet))
+	assert.False(t, readOnly.Allows(http.MethodPost))
+	assert.False(t, readOnly.Allows(http.MethodDelete))
+	assert.False(t, (&entity.EmailVerificationPolicy{Restriction: entity.UnverifiedBlock}).Allows(http.MethodGet))
+	assert.True(t, (&entity.EmailVerificationPolicy{Restriction: entity.UnverifiedAllow}).Allows(http.MethodPost))
+
+	var restriction entity.UnverifiedRestriction
+	assert.Nil(t, restriction.Set("read_only"))
+	assert.Equal(t, entity.UnverifiedReadOnly, restriction)
+	assert.NotNil(t, restriction.Set("readonly"))
+}
+
+func TestEmailVerificationToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	user := &entity.User{ID: 7, Email: "user@test.com"}
+	token := entity.NewEmailVerificationToken(secret, user, now.Add(time.Hour))
+
+	userID, err := entity.ParseEmailVerificationToken(token)
+	assert.Nil(t, err)
+	assert.Equal(t, entity.UserID(7), userID)
+	assert.Nil(t, entity.VerifyEmailVerificationToken(secret, token, user, now))
+
+	// test expired tokens, other secrets and changed emails are rejected
+	assert.ErrorIs(t, entity.VerifyEmailVerificationToken(secret, token, user, now.Add(2*time.Hour)), entity.ErrInvalidEmailVerificationToken)
+	assert.ErrorIs(t, entity.VerifyEmailVerificationToken([]byte("other"), token, user, now), entity.ErrInvalidEmailVerificationToken)
+	assert.ErrorIs(t, entity.VerifyEmailVerificationToken(secret, token, &entity.User{ID: 7, Email: "new@test.com"}, now), entity.ErrInvalidEmailVerificationToken)
+	assert.ErrorIs(t, entity.VerifyEmailVerificationToken(secret, token, &entity.User{ID: 8, Email: "user@test.com"}, now), entity.ErrInvalidEmailVerificationToken)
+
+	// test malformed tokens are rejected
+	_, err = entity.ParseEmailVerificationToken("garbage")
+	assert.ErrorIs(t, err, entity.ErrInvalidEmailVerificationToken)
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	EstimateMinutes EstimateUnit = "minutes"
+	EstimatePoints  EstimateUnit = "points"
+)
+
+const (
+	// DefaultEstimateReportWeeks は期間が指定されなかったときに集計する週の数
+	DefaultEstimateReportWeeks = 12
+	MaxEstimateReportWeeks     = 104
+)
+
+var (
+	ErrInvalidEstimate     = errors.New("Estimate must not be negative")
+	ErrInvalidReportPeriod = errors.New("Invalid report period")
+)
+
+type EstimateUnit string
+
+func (u *EstimateUnit) IsValid() bool {
+	return *u == EstimateMinutes || *u == EstimatePoints
+}
+
+func (u *EstimateUnit) Set(value string) error {
+	newUnit := EstimateUnit(value)
+	if !newUnit.IsValid() {
+		return errors.New("Invalid value for EstimateUnit")
+	}
+	*u = newUnit
+	return nil
+}
+
+// Value は見積もりのないタスクの単位を空文字ではなく NULL で保存する
+func (u EstimateUnit) Value() (driver.Value, error) {
+	if u == "" {
+		return nil, nil
+	}
+	return string(u), nil
+}
+
+// Estimate はタスクの見積もり。分またはストーリーポイントで表す
+type Estimate struct {
+	Value int
+	Unit  EstimateUnit
+}
+
+func NewEstimate(value int, unit string) (*Estimate, error) {
+	if value < 0 {
+		return nil, ErrInvalidEstimate
+	}
+	estimate := Estimate{Value: value}
+	if err := estimate.Unit.Set(unit); err != nil {
+		return nil, err
+	}
+	return &estimate, nil
+}
+
+func (e Estimate) String() string {
+	return fmt.Sprintf("%d %s", e.Value, e.Unit)
+}
+
+// EstimateReportQuery は見積もりレポートの集計期間。完了日時が [From, To) のタスクを集計する
+type EstimateReportQuery struct {
+	From time.Time
+	To   time.Time
+}
+
+// Normalize は期間が指定されていなければ now を含む週までの直近の週にし、長すぎる期間を拒否する
+func (q *EstimateReportQuery) Normalize(now time.Time) error {
+	if q.To.IsZero() {
+		q.To = WeekStart(now).AddDate(0, 0, 7)
+	}
+	if q.From.IsZero() {
+		q.From = q.To.AddDate(0, 0, -7*DefaultEstimateReportWeeks)
+	}
+	if !q.From.Before(q.To) || q.To.Sub(q.From) > MaxEstimateReportWeeks*7*24*time.Hour {
+		return ErrInvalidReportPeriod
+	}
+	return nil
+}
+
+// WeekStart は t を含む週の月曜日 0 時 (UTC) を返す
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// EstimateReportRow は 1 人のユーザーが 1 週間に完了したタスクを見積もりの単位ごとに集計した結果。
+// Unit が nil の行は見積もりのないタスク。サイクルタイムは着手 (未着手なら作成) から完了までの時間
+type EstimateReportRow struct {
+	UserID         UserID
+	WeekStart      time.Time
+	Unit           *EstimateUnit
+	TaskCount      int
+	EstimateTotal  int
+	CycleSeconds   int64
+	TrackedSeconds int64
+}
@@ -0,0 +1,62 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEstimate(t *testing.T) {
+	estimate, err := entity.NewEstimate(3, "points")
+	assert.Nil(t, err)
+	assert.Equal(t, "3 points", estimate.String())
+
+	_, err = entity.NewEstimate(-1, "minutes")
+	assert.ErrorIs(t, err, entity.ErrInvalidEstimate)
+	_, err = entity.NewEstimate(30, "hours")
+	assert.NotNil(t, err)
+}
+
+func TestTrackCycle(t *testing.T) {
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	task := entity.Task{Status: entity.Status{Name: entity.InProgress}}
+
+	// test the first start is kept
+	task.TrackCycle(entity.Todo, start)
+	assert.Equal(t, start, *task.WorkStartedAt)
+	task.Status.Name = entity.Pending
+	task.TrackCycle(entity.InProgress, start.Add(time.Hour))
+	task.Status.Name = entity.InProgress
+	task.TrackCycle(entity.Pending, start.Add(2*time.Hour))
+	assert.Equal(t, start, *task.WorkStartedAt)
+
+	// test completion survives archiving and is cleared on reopen
+	done := start.Add(3 * time.Hour)
+	task.Status.Name = entity.Done
+	task.TrackCycle(entity.InProgress, done)
+	assert.Equal(t, done, *task.CompletedAt)
+	task.Status.Name = entity.Archive
+	task.TrackCycle(entity.Done, done.Add(time.Hour))
+	assert.Equal(t, done, *task.CompletedAt)
+	task.Status.Name = entity.Todo
+	task.TrackCycle(entity.Archive, done.Add(2*time.Hour))
+	assert.Nil(t, task.CompletedAt)
+}
+
+func TestEstimateReportQueryNormalize(t *testing.T) {
+	// 2025-01-08 is a Wednesday
+	now := time.Date(2025, 1, 8, 15, 0, 0, 0, time.UTC)
+	query := entity.EstimateReportQuery{}
+	assert.Nil(t, query.Normalize(now))
+	assert.Equal(t, time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), query.To)
+	assert.Equal(t, query.To.AddDate(0, 0, -7*entity.DefaultEstimateReportWeeks), query.From)
+
+	query = entity.EstimateReportQuery{From: now, To: now}
+	assert.ErrorIs(t, query.Normalize(now), entity.ErrInvalidReportPeriod)
+	query = entity.EstimateReportQuery{From: now.AddDate(-3, 0, 0), To: now}
+	assert.ErrorIs(t, query.Normalize(now), entity.ErrInvalidReportPeriod)
+
+	assert.Equal(t, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), entity.WeekStart(time.Date(2025, 1, 12, 23, 0, 0, 0, time.UTC)))
+}
@@ -1,5 +1,5 @@
 package entity
 
 func NewDomains() []any {
-	return []any{&Status{}, &Task{}, &User{}}
+	return []any{&Status{}, &Tag{}, &Project{}, &Task{}, &TaskEvent{}, &TaskDependency{}, &ChecklistItem{}, &Comment{}, &Attachment{}, &TimeEntry{}, &SavedView{}, &Session{}, &RefreshToken{}, &RevokedToken{}, &PasswordResetToken{}, &User{}}
 }
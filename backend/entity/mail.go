@@ -0,0 +1,8 @@
+package entity
+
+// Mail はユーザーに送るテキストのメール
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
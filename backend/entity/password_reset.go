@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const PasswordResetTokenLifetime = time.Hour
+
+var ErrInvalidPasswordResetToken = errors.New("Invalid or expired password reset token")
+
+type PasswordResetTokenID int
+
+// PasswordResetToken はパスワードの再設定用に送ったトークンのハッシュ。一度使うと UsedAt が設定され、再び使えない
+type PasswordResetToken struct {
+	ID        PasswordResetTokenID `gorm:"primaryKey"`
+	UserID    UserID               `gorm:"not null; index"`
+	User      User                 `gorm:"not null; foreignKey:UserID; constraint:OnDelete:CASCADE"`
+	TokenHash string               `gorm:"not null; uniqueIndex"`
+	ExpiresAt time.Time            `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// NewPasswordResetToken はランダムなトークンを作り、保存するハッシュとメールで送る平文を返す
+func NewPasswordResetToken(userID UserID, now time.Time) (*PasswordResetToken, string, error) {
+	raw, err := NewRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	return &PasswordResetToken{
+		UserID:    userID,
+		TokenHash: HashToken(raw),
+		ExpiresAt: now.Add(PasswordResetTokenLifetime),
+	}, raw, nil
+}
+
+func (t *PasswordResetToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,47 @@
+package entity
+
+import "errors"
+
+const (
+	PriorityNone   Priority = "none"
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+// Priorities は緊急度の低い順に並んだ優先度の一覧
+var Priorities = []Priority{PriorityNone, PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent}
+
+type Priority string
+
+func NewPriority(value string) (*Priority, error) {
+	var priority Priority
+	if err := priority.Set(value); err != nil {
+		return nil, err
+	}
+	return &priority, nil
+}
+
+func (p *Priority) IsValid() bool {
+	return *p == PriorityNone || *p == PriorityLow || *p == PriorityMedium || *p == PriorityHigh || *p == PriorityUrgent
+}
+
+func (p *Priority) Set(value string) error {
+	newPriority := Priority(value)
+	if !newPriority.IsValid() {
+		return errors.New("Invalid value for Priority")
+	}
+	*p = newPriority
+	return nil
+}
+
+// Rank は並び替え用の数値を返す。緊急度が高いほど大きい
+func (p Priority) Rank() int {
+	for i, priority := range Priorities {
+		if priority == p {
+			return i
+		}
+	}
+	return 0
+}
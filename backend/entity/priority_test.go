@@ -0,0 +1,20 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriority(t *testing.T) {
+	priority, err := entity.NewPriority("high")
+	assert.Nil(t, err)
+	assert.Equal(t, entity.PriorityHigh, *priority)
+	assert.Greater(t, entity.PriorityUrgent.Rank(), priority.Rank())
+	assert.Greater(t, priority.Rank(), entity.PriorityNone.Rank())
+
+	priority, err = entity.NewPriority("critical")
+	assert.Nil(t, priority)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+const (
+	// DeleteModeInbox はプロジェクトのタスクを受信箱 (プロジェクトなし) に移動してから削除する
+	DeleteModeInbox ProjectDeleteMode = "inbox"
+	// DeleteModeCascade はプロジェクトのタスクもまとめて削除する
+	DeleteModeCascade ProjectDeleteMode = "cascade"
+)
+
+const DefaultProjectColor Color = "#808080"
+
+var ErrProjectNotFound = errors.New("Project not found")
+
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Color は #RRGGBB 形式の色
+type Color string
+
+func NewColor(value string) (*Color, error) {
+	var color Color
+	if err := color.Set(value); err != nil {
+		return nil, err
+	}
+	return &color, nil
+}
+
+func (c *Color) IsValid() bool {
+	return colorPattern.MatchString(string(*c))
+}
+
+func (c *Color) Set(value string) error {
+	newColor := Color(value)
+	if !newColor.IsValid() {
+		return errors.New("Invalid value for Color")
+	}
+	*c = newColor
+	return nil
+}
+
+type ProjectDeleteMode string
+
+func NewProjectDeleteMode(value string) (*ProjectDeleteMode, error) {
+	var mode ProjectDeleteMode
+	if err := mode.Set(value); err != nil {
+		return nil, err
+	}
+	return &mode, nil
+}
+
+func (m *ProjectDeleteMode) IsValid() bool {
+	return *m == DeleteModeInbox || *m == DeleteModeCascade
+}
+
+func (m *ProjectDeleteMode) Set(value string) error {
+	newMode := ProjectDeleteMode(value)
+	if !newMode.IsValid() {
+		return errors.New("Invalid value for ProjectDeleteMode")
+	}
+	*m = newMode
+	return nil
+}
+
+type ProjectID int
+
+type Project struct {
+	ID        ProjectID `gorm:"primaryKey"`
+	Name      string    `gorm:"not null; uniqueIndex:idx_projects_user_id_name"`
+	Color     Color     `gorm:"not null"`
+	Archived  bool      `gorm:"not null; default:false"`
+	UserID    UserID    `gorm:"not null; uniqueIndex:idx_projects_user_id_name"`
+	User      User      `gorm:"not null; foreignKey:UserID"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -0,0 +1,32 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewColor(t *testing.T) {
+	color, err := entity.NewColor("#1a2B3c")
+	assert.Nil(t, err)
+	assert.Equal(t, entity.Color("#1a2B3c"), *color)
+
+	for _, invalid := range []string{"", "1a2b3c", "#1a2b3", "#1a2b3c4", "#gggggg", "red"} {
+		_, err := entity.NewColor(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestNewProjectDeleteMode(t *testing.T) {
+	mode, err := entity.NewProjectDeleteMode("cascade")
+	assert.Nil(t, err)
+	assert.Equal(t, entity.DeleteModeCascade, *mode)
+
+	mode, err = entity.NewProjectDeleteMode("inbox")
+	assert.Nil(t, err)
+	assert.Equal(t, entity.DeleteModeInbox, *mode)
+
+	_, err = entity.NewProjectDeleteMode("archive")
+	assert.NotNil(t, err)
+}
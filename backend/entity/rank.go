@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+// MaxRankLength を超える Rank が必要になったら列全体の Rank を振り直す
+const MaxRankLength = 32
+
+// rankDigits は Rank に使う文字。ASCII 順に並んでいるため文字列比較で順序が決まる
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+var (
+	ErrRankOrder   = errors.New("Rank must be less than the next rank")
+	ErrInvalidMove = errors.New("Neighbour tasks must be in the target status column")
+)
+
+// Rank はステータス列の中でのタスクの位置を表す文字列。
+// 2 つの Rank の間に必ず別の Rank を作れるため、並び替えで更新するのは移動したタスクだけでよい
+type Rank string
+
+func rankDigit(r Rank, i int) int {
+	if i >= len(r) {
+		return 0
+	}
+	return strings.IndexByte(rankDigits, r[i])
+}
+
+// RankBetween は prev より大きく next より小さい Rank を返す。
+// prev が空なら先頭、next が空なら末尾を表す。生成される Rank は末尾が最小の文字にならない
+func RankBetween(prev Rank, next Rank) (Rank, error) {
+	if next != "" && prev >= next {
+		return "", ErrRankOrder
+	}
+
+	var b strings.Builder
+	bounded := next != ""
+	for i := 0; ; i++ {
+		low := rankDigit(prev, i)
+		high := len(rankDigits)
+		if bounded {
+			if i >= len(next) {
+				return "", ErrRankOrder
+			}
+			high = rankDigit(next, i)
+		}
+		if high-low > 1 {
+			b.WriteByte(rankDigits[(low+high)/2])
+			return Rank(b.String()), nil
+		}
+		// 間に文字が無ければ prev の文字を使って次の桁で決める
+		b.WriteByte(rankDigits[low])
+		if high > low {
+			bounded = false
+		}
+	}
+}
+
+// RankAfter は prev より大きい Rank を返す。末尾への追加で Rank が長くなりにくいよう、
+// 中間ではなく最初に増やせる桁を 1 つ増やす
+func RankAfter(prev Rank) Rank {
+	for i := 0; i < len(prev); i++ {
+		digit := rankDigit(prev, i)
+		if digit < len(rankDigits)-1 {
+			return prev[:i] + Rank(rankDigits[digit+1])
+		}
+	}
+	rank, _ := RankBetween(prev, "")
+	return rank
+}
+
+// SpreadRanks は n 個のタスクに等間隔の Rank を割り当てる。列全体を振り直すときに使う
+func SpreadRanks(n int) []Rank {
+	base := len(rankDigits)
+	width, capacity := 1, base
+	for capacity < n+1 {
+		width++
+		capacity *= base
+	}
+
+	ranks := make([]Rank, n)
+	digits := make([]byte, width)
+	for i := range ranks {
+		value := (i + 1) * capacity / (n + 1)
+		for j := width - 1; j >= 0; j-- {
+			digits[j] = rankDigits[value%base]
+			value /= base
+		}
+		ranks[i] = Rank(strings.TrimRight(string(digits), rankDigits[:1]))
+	}
+	return ranks
+}
@@ -0,0 +1,84 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankBetween(t *testing.T) {
+	for _, c := range []struct {
+		prev entity.Rank
+		next entity.Rank
+	}{
+		{"", ""},
+		{"", "i"},
+		{"i", ""},
+		{"a", "b"},
+		{"a", "a1"},
+		{"az", "b"},
+		{"zz", ""},
+		{"", "01"},
+		{"a9", "aa"},
+	} {
+		rank, err := entity.RankBetween(c.prev, c.next)
+		assert.Nil(t, err)
+		assert.Greater(t, rank, c.prev, "%q %q", c.prev, c.next)
+		if c.next != "" {
+			assert.Less(t, rank, c.next, "%q %q", c.prev, c.next)
+		}
+		assert.NotEqual(t, byte('0'), rank[len(rank)-1])
+	}
+
+	_, err := entity.RankBetween("b", "a")
+	assert.ErrorIs(t, err, entity.ErrRankOrder)
+	_, err = entity.RankBetween("a", "a")
+	assert.ErrorIs(t, err, entity.ErrRankOrder)
+}
+
+func TestRankBetweenRepeatedInsertion(t *testing.T) {
+	// inserting at the same place keeps producing ordered ranks
+	prev, next := entity.Rank("a"), entity.Rank("b")
+	for i := 0; i < 100; i++ {
+		rank, err := entity.RankBetween(prev, next)
+		assert.Nil(t, err)
+		assert.True(t, prev < rank && rank < next)
+		next = rank
+	}
+
+}
+
+func TestRankAfter(t *testing.T) {
+	assert.Equal(t, entity.Rank("i"), entity.RankAfter(""))
+	assert.Equal(t, entity.Rank("b"), entity.RankAfter("a"))
+	assert.Equal(t, entity.Rank("b"), entity.RankAfter("a9x"))
+	assert.Equal(t, entity.Rank("z1"), entity.RankAfter("z0z"))
+	assert.Equal(t, entity.Rank("zzi"), entity.RankAfter("zz"))
+
+	// appending to the end grows slowly
+	var last entity.Rank
+	for i := 0; i < 100; i++ {
+		rank := entity.RankAfter(last)
+		assert.Greater(t, rank, last)
+		last = rank
+	}
+	assert.LessOrEqual(t, len(last), 6)
+}
+
+func TestSpreadRanks(t *testing.T) {
+	assert.Empty(t, entity.SpreadRanks(0))
+	assert.Equal(t, []entity.Rank{"i"}, entity.SpreadRanks(1))
+
+	for _, n := range []int{3, 35, 36, 1000} {
+		ranks := entity.SpreadRanks(n)
+		assert.Len(t, ranks, n)
+		for i := range ranks {
+			assert.NotEmpty(t, ranks[i])
+			assert.NotEqual(t, byte('0'), ranks[i][len(ranks[i])-1])
+			if i > 0 {
+				assert.Less(t, ranks[i-1], ranks[i])
+			}
+		}
+	}
+}
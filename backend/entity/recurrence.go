@@ -0,0 +1,388 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+// 次の発生日を探す際の周期の上限。BYDAY=5MO のように該当しない周期が続いても無限ループしないようにする
+const maxRecurrencePeriods = 1000
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type Frequency string
+
+func (f *Frequency) IsValid() bool {
+	return *f == Daily || *f == Weekly || *f == Monthly || *f == Yearly
+}
+
+// RecurrenceDay は BYDAY の要素。Ordinal は MONTHLY でのみ使い、2MO なら第 2 月曜、-1FR なら最終金曜
+type RecurrenceDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+func (d RecurrenceDay) String() string {
+	code := strings.ToUpper(d.Weekday.String()[:2])
+	if d.Ordinal == 0 {
+		return code
+	}
+	return strconv.Itoa(d.Ordinal) + code
+}
+
+// RecurrenceRule は RFC 5545 RRULE のサブセット
+// (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYMONTH, COUNT, UNTIL)。
+// 月末を超える日付 (2 月 30 日など) は RFC 5545 と異なりスキップせず、その月の末日に丸める。
+// COUNT はこのタスクを含めた残りの発生回数を表し、次のタスクを生成するたびに 1 減る
+type RecurrenceRule struct {
+	Freq       Frequency
+	Interval   int
+	ByDay      []RecurrenceDay
+	ByMonthDay int
+	ByMonth    time.Month
+	Count      int
+	Until      *time.Time
+}
+
+func ParseRecurrenceRule(value string) (*RecurrenceRule, error) {
+	rule := RecurrenceRule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(value), "RRULE:"), ";") {
+		key, val, found := strings.Cut(part, "=")
+		if !found || val == "" {
+			return nil, fmt.Errorf("Invalid recurrence rule part: %q", part)
+		}
+		key = strings.ToUpper(key)
+		if seen[key] {
+			return nil, fmt.Errorf("Duplicate recurrence rule part: %s", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			rule.Freq = Frequency(strings.ToUpper(val))
+			if !rule.Freq.IsValid() {
+				return nil, fmt.Errorf("Invalid value for FREQ: %s", val)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("Invalid value for INTERVAL: %s", val)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("Invalid value for COUNT: %s", val)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseUntil(val)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, code := range strings.Split(strings.ToUpper(val), ",") {
+				day, err := parseRecurrenceDay(code)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, *day)
+			}
+		case "BYMONTHDAY":
+			day, err := strconv.Atoi(val)
+			if err != nil || day == 0 || day < -31 || day > 31 {
+				return nil, fmt.Errorf("Invalid value for BYMONTHDAY: %s", val)
+			}
+			rule.ByMonthDay = day
+		case "BYMONTH":
+			month, err := strconv.Atoi(val)
+			if err != nil || month < 1 || month > 12 {
+				return nil, fmt.Errorf("Invalid value for BYMONTH: %s", val)
+			}
+			rule.ByMonth = time.Month(month)
+		default:
+			return nil, fmt.Errorf("Unsupported recurrence rule part: %s", key)
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if until, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return until, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("Invalid value for UNTIL: %s", value)
+}
+
+func parseRecurrenceDay(code string) (*RecurrenceDay, error) {
+	if len(code) < 2 {
+		return nil, fmt.Errorf("Invalid value for BYDAY: %s", code)
+	}
+	weekday, ok := weekdayCodes[code[len(code)-2:]]
+	if !ok {
+		return nil, fmt.Errorf("Invalid value for BYDAY: %s", code)
+	}
+	day := RecurrenceDay{Weekday: weekday}
+	if prefix := code[:len(code)-2]; prefix != "" {
+		ordinal, err := strconv.Atoi(prefix)
+		if err != nil || ordinal == 0 || ordinal < -5 || ordinal > 5 {
+			return nil, fmt.Errorf("Invalid value for BYDAY: %s", code)
+		}
+		day.Ordinal = ordinal
+	}
+	return &day, nil
+}
+
+func (r *RecurrenceRule) validate() error {
+	if r.Freq == "" {
+		return errors.New("FREQ is required in recurrence rule")
+	}
+	if r.Count != 0 && r.Until != nil {
+		return errors.New("COUNT and UNTIL must not be used together")
+	}
+	for _, day := range r.ByDay {
+		if day.Ordinal != 0 && r.Freq != Monthly {
+			return errors.New("BYDAY with an ordinal is only supported with FREQ=MONTHLY")
+		}
+	}
+	if len(r.ByDay) > 0 && r.Freq == Yearly {
+		return errors.New("BYDAY is not supported with FREQ=YEARLY")
+	}
+	if len(r.ByDay) > 0 && r.ByMonthDay != 0 {
+		return errors.New("BYDAY and BYMONTHDAY must not be used together")
+	}
+	if r.ByMonthDay != 0 && r.Freq != Monthly && r.Freq != Yearly {
+		return errors.New("BYMONTHDAY is only supported with FREQ=MONTHLY or FREQ=YEARLY")
+	}
+	if r.ByMonth != 0 && r.Freq != Yearly {
+		return errors.New("BYMONTH is only supported with FREQ=YEARLY")
+	}
+	return nil
+}
+
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.ByMonth != 0 {
+		parts = append(parts, "BYMONTH="+strconv.Itoa(int(r.ByMonth)))
+	}
+	if r.ByMonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.ByMonthDay))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			codes[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.Count != 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+func (r RecurrenceRule) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+func (r *RecurrenceRule) Scan(value any) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("Invalid type for RecurrenceRule: %T", value)
+	}
+	rule, err := ParseRecurrenceRule(s)
+	if err != nil {
+		return err
+	}
+	*r = *rule
+	return nil
+}
+
+// Next は from (現在の発生日) より後の最初の発生日と、次のタスクに引き継ぐルールを返す。
+// COUNT を使い切った場合や UNTIL を過ぎる場合は ok が false になる
+func (r RecurrenceRule) Next(from time.Time) (next time.Time, rule *RecurrenceRule, ok bool) {
+	if r.Count == 1 {
+		return time.Time{}, nil, false
+	}
+
+	anchored := r.anchored(from)
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, candidate := range anchored.candidates(from, period*anchored.interval()) {
+			if !candidate.After(from) {
+				continue
+			}
+			if anchored.Until != nil && candidate.After(*anchored.Until) {
+				return time.Time{}, nil, false
+			}
+			if anchored.Count > 1 {
+				anchored.Count--
+			}
+			return candidate, &anchored, true
+		}
+	}
+	return time.Time{}, nil, false
+}
+
+func (r RecurrenceRule) interval() int {
+	if r.Interval < 1 {
+		return 1
+	}
+	return r.Interval
+}
+
+// anchored は月末や閏日の発生日がずれていかないよう、基準となる日付をルールに固定したコピーを返す
+func (r RecurrenceRule) anchored(from time.Time) RecurrenceRule {
+	anchored := r
+	anchored.ByDay = append([]RecurrenceDay(nil), r.ByDay...)
+	switch r.Freq {
+	case Monthly:
+		if len(r.ByDay) == 0 && r.ByMonthDay == 0 {
+			anchored.ByMonthDay = from.Day()
+		}
+	case Yearly:
+		if r.ByMonth == 0 {
+			anchored.ByMonth = from.Month()
+		}
+		if r.ByMonthDay == 0 {
+			anchored.ByMonthDay = from.Day()
+		}
+	}
+	return anchored
+}
+
+// candidates は from を含む周期から offset 周期後の発生日候補を昇順で返す
+func (r RecurrenceRule) candidates(from time.Time, offset int) []time.Time {
+	year, month, day := from.Date()
+	clock := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, from.Hour(), from.Minute(), from.Second(), from.Nanosecond(), from.Location())
+	}
+
+	switch r.Freq {
+	case Daily:
+		candidate := clock(year, month, day+offset)
+		if len(r.ByDay) > 0 && !r.matchesWeekday(candidate.Weekday()) {
+			return nil
+		}
+		return []time.Time{candidate}
+
+	case Weekly:
+		// 週の始まりは月曜 (WKST=MO)
+		weekStart := clock(year, month, day-(int(from.Weekday())+6)%7+offset*7)
+		if len(r.ByDay) == 0 {
+			return []time.Time{weekStart.AddDate(0, 0, (int(from.Weekday())+6)%7)}
+		}
+		candidates := []time.Time{}
+		for i := 0; i < 7; i++ {
+			candidate := weekStart.AddDate(0, 0, i)
+			if r.matchesWeekday(candidate.Weekday()) {
+				candidates = append(candidates, candidate)
+			}
+		}
+		return candidates
+
+	case Monthly:
+		first := clock(year, month+time.Month(offset), 1)
+		if len(r.ByDay) == 0 {
+			return []time.Time{clock(first.Year(), first.Month(), monthDay(first.Year(), first.Month(), r.ByMonthDay))}
+		}
+		return r.weekdaysInMonth(first)
+
+	case Yearly:
+		y := year + offset
+		return []time.Time{clock(y, r.ByMonth, monthDay(y, r.ByMonth, r.ByMonthDay))}
+	}
+	return nil
+}
+
+func (r RecurrenceRule) matchesWeekday(weekday time.Weekday) bool {
+	for _, day := range r.ByDay {
+		if day.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// weekdaysInMonth は first の月で BYDAY に該当する日を昇順で返す
+func (r RecurrenceRule) weekdaysInMonth(first time.Time) []time.Time {
+	days := daysInMonth(first.Year(), first.Month())
+	candidates := []time.Time{}
+	for _, day := range r.ByDay {
+		matches := []time.Time{}
+		for d := 0; d < days; d++ {
+			candidate := first.AddDate(0, 0, d)
+			if candidate.Weekday() == day.Weekday {
+				matches = append(matches, candidate)
+			}
+		}
+		switch {
+		case day.Ordinal == 0:
+			candidates = append(candidates, matches...)
+		case day.Ordinal > 0 && day.Ordinal <= len(matches):
+			candidates = append(candidates, matches[day.Ordinal-1])
+		case day.Ordinal < 0 && -day.Ordinal <= len(matches):
+			candidates = append(candidates, matches[len(matches)+day.Ordinal])
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+	return candidates
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// monthDay は BYMONTHDAY をその月の実際の日に変換する。負の値は月末から数え、月の範囲外は月初・月末に丸める
+func monthDay(year int, month time.Month, byMonthDay int) int {
+	days := daysInMonth(year, month)
+	day := byMonthDay
+	if day < 0 {
+		day = days + day + 1
+	}
+	if day < 1 {
+		return 1
+	}
+	if day > days {
+		return days
+	}
+	return day
+}
@@ -0,0 +1,172 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"backend/pkg"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// occurrences は from から順に次の発生日を最大 n 件たどる
+func occurrences(t *testing.T, rrule string, from string, n int) []string {
+	rule, err := entity.ParseRecurrenceRule(rrule)
+	assert.Nil(t, err)
+
+	dates := []string{}
+	current := pkg.Str2time(from)
+	for i := 0; i < n; i++ {
+		next, nextRule, ok := rule.Next(current)
+		if !ok {
+			break
+		}
+		dates = append(dates, next.Format("2006-01-02"))
+		current, rule = next, nextRule
+	}
+	return dates
+}
+
+func TestParseRecurrenceRule(t *testing.T) {
+	rule, err := entity.ParseRecurrenceRule("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=10")
+	assert.Nil(t, err)
+	assert.Equal(t, entity.Weekly, rule.Freq)
+	assert.Equal(t, 2, rule.Interval)
+	assert.Equal(t, []entity.RecurrenceDay{{Weekday: time.Monday}, {Weekday: time.Friday}}, rule.ByDay)
+	assert.Equal(t, 10, rule.Count)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=10", rule.String())
+
+	rule, err = entity.ParseRecurrenceRule("FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20251231")
+	assert.Nil(t, err)
+	assert.Equal(t, []entity.RecurrenceDay{{Ordinal: -1, Weekday: time.Friday}}, rule.ByDay)
+	assert.Equal(t, time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), *rule.Until)
+	assert.Equal(t, "FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20251231T000000Z", rule.String())
+
+	for _, invalid := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=YEARLY;BYDAY=MO",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYMONTH=2",
+		"FREQ=DAILY;UNTIL=tomorrow",
+		"FREQ=DAILY;WKST=SU",
+	} {
+		_, err := entity.ParseRecurrenceRule(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestRecurrenceRuleScan(t *testing.T) {
+	var rule entity.RecurrenceRule
+	assert.Nil(t, rule.Scan([]byte("FREQ=DAILY;INTERVAL=3")))
+	assert.Equal(t, entity.Daily, rule.Freq)
+	assert.Equal(t, 3, rule.Interval)
+
+	value, err := rule.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "FREQ=DAILY;INTERVAL=3", value)
+
+	assert.NotNil(t, rule.Scan(1))
+}
+
+func TestRecurrenceDaily(t *testing.T) {
+	assert.Equal(t, []string{"2025-01-02", "2025-01-03", "2025-01-04"}, occurrences(t, "FREQ=DAILY", "2025-01-01", 3))
+	assert.Equal(t, []string{"2025-01-04", "2025-01-07"}, occurrences(t, "FREQ=DAILY;INTERVAL=3", "2025-01-01", 2))
+	// 2025-01-01 is a Wednesday
+	assert.Equal(t, []string{"2025-01-02", "2025-01-03", "2025-01-06"}, occurrences(t, "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", "2025-01-01", 3))
+	assert.Equal(t, []string{"2025-02-28", "2025-03-01"}, occurrences(t, "FREQ=DAILY", "2025-02-27", 2))
+	assert.Equal(t, []string{"2024-02-29", "2024-03-01"}, occurrences(t, "FREQ=DAILY", "2024-02-28", 2))
+}
+
+func TestRecurrenceWeekly(t *testing.T) {
+	assert.Equal(t, []string{"2025-01-08", "2025-01-15"}, occurrences(t, "FREQ=WEEKLY", "2025-01-01", 2))
+	assert.Equal(t, []string{"2025-01-03", "2025-01-06", "2025-01-10"}, occurrences(t, "FREQ=WEEKLY;BYDAY=MO,FR", "2025-01-01", 3))
+	// every other week on Monday and Friday, starting from the week of 2025-01-01
+	assert.Equal(t, []string{"2025-01-03", "2025-01-13", "2025-01-17", "2025-01-27"}, occurrences(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", "2025-01-01", 4))
+	// Sunday belongs to the week starting on the previous Monday
+	assert.Equal(t, []string{"2025-01-06", "2025-01-12"}, occurrences(t, "FREQ=WEEKLY;BYDAY=SU,MO", "2025-01-05", 2))
+	assert.Equal(t, []string{"2024-12-30", "2025-01-06"}, occurrences(t, "FREQ=WEEKLY;BYDAY=MO", "2024-12-27", 2))
+}
+
+func TestRecurrenceMonthlyMonthEnd(t *testing.T) {
+	// the 31st is kept as the anchor and clamped to the last day of shorter months
+	assert.Equal(t,
+		[]string{"2025-02-28", "2025-03-31", "2025-04-30", "2025-05-31"},
+		occurrences(t, "FREQ=MONTHLY", "2025-01-31", 4))
+	assert.Equal(t,
+		[]string{"2024-02-29", "2024-03-31"},
+		occurrences(t, "FREQ=MONTHLY", "2024-01-31", 2))
+	assert.Equal(t,
+		[]string{"2025-02-28", "2025-03-30"},
+		occurrences(t, "FREQ=MONTHLY", "2025-01-30", 2))
+	assert.Equal(t,
+		[]string{"2025-03-31", "2025-05-31", "2025-07-31"},
+		occurrences(t, "FREQ=MONTHLY;INTERVAL=2", "2025-01-31", 3))
+	assert.Equal(t,
+		[]string{"2024-12-30", "2025-01-30", "2025-02-28"},
+		occurrences(t, "FREQ=MONTHLY", "2024-11-30", 3))
+	assert.Equal(t,
+		[]string{"2024-02-29", "2024-03-31", "2024-04-30"},
+		occurrences(t, "FREQ=MONTHLY;BYMONTHDAY=-1", "2024-01-31", 3))
+}
+
+func TestRecurrenceMonthlyAnchorIsCarried(t *testing.T) {
+	rule, err := entity.ParseRecurrenceRule("FREQ=MONTHLY")
+	assert.Nil(t, err)
+	next, nextRule, ok := rule.Next(pkg.Str2time("2025-01-31"))
+	assert.True(t, ok)
+	assert.Equal(t, pkg.Str2time("2025-02-28"), next)
+	assert.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=31", nextRule.String())
+	assert.Equal(t, "FREQ=MONTHLY", rule.String())
+}
+
+func TestRecurrenceMonthlyByDay(t *testing.T) {
+	// second Monday of every month
+	assert.Equal(t, []string{"2025-01-13", "2025-02-10", "2025-03-10"}, occurrences(t, "FREQ=MONTHLY;BYDAY=2MO", "2025-01-01", 3))
+	// last Friday of every month
+	assert.Equal(t, []string{"2025-01-31", "2025-02-28", "2025-03-28"}, occurrences(t, "FREQ=MONTHLY;BYDAY=-1FR", "2025-01-01", 3))
+	// months without a fifth Monday are skipped
+	assert.Equal(t, []string{"2025-03-31", "2025-06-30"}, occurrences(t, "FREQ=MONTHLY;BYDAY=5MO", "2025-01-01", 2))
+	// first and third Thursday
+	assert.Equal(t, []string{"2025-01-16", "2025-02-06", "2025-02-20"}, occurrences(t, "FREQ=MONTHLY;BYDAY=1TH,3TH", "2025-01-02", 3))
+}
+
+func TestRecurrenceYearlyLeapDay(t *testing.T) {
+	// Feb 29 falls back to Feb 28 in common years and returns to Feb 29 in leap years
+	assert.Equal(t,
+		[]string{"2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"},
+		occurrences(t, "FREQ=YEARLY", "2024-02-29", 4))
+	assert.Equal(t,
+		[]string{"2028-02-29", "2032-02-29"},
+		occurrences(t, "FREQ=YEARLY;INTERVAL=4", "2024-02-29", 2))
+	assert.Equal(t,
+		[]string{"2025-03-15", "2026-03-15"},
+		occurrences(t, "FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=15", "2025-01-01", 2))
+	assert.Equal(t,
+		[]string{"2026-01-01"},
+		occurrences(t, "FREQ=YEARLY", "2025-01-01", 1))
+}
+
+func TestRecurrenceCountAndUntil(t *testing.T) {
+	// COUNT includes the current occurrence
+	assert.Equal(t, []string{"2025-01-02", "2025-01-03"}, occurrences(t, "FREQ=DAILY;COUNT=3", "2025-01-01", 10))
+	assert.Empty(t, occurrences(t, "FREQ=DAILY;COUNT=1", "2025-01-01", 10))
+
+	rule, err := entity.ParseRecurrenceRule("FREQ=DAILY;COUNT=3")
+	assert.Nil(t, err)
+	_, nextRule, ok := rule.Next(pkg.Str2time("2025-01-01"))
+	assert.True(t, ok)
+	assert.Equal(t, 2, nextRule.Count)
+	assert.Equal(t, 3, rule.Count)
+
+	// UNTIL is inclusive
+	assert.Equal(t, []string{"2025-01-08", "2025-01-15"}, occurrences(t, "FREQ=WEEKLY;UNTIL=20250115", "2025-01-01", 10))
+	assert.Equal(t, []string{"2025-02-28"}, occurrences(t, "FREQ=MONTHLY;UNTIL=20250330T000000Z", "2025-01-31", 10))
+}
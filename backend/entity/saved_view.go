@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrSavedViewNotFound = errors.New("Saved view not found")
+
+type SavedViewID int
+
+// SavedView はユーザーが名前を付けて保存したタスク一覧の絞り込み条件
+type SavedView struct {
+	ID        SavedViewID `gorm:"primaryKey"`
+	Name      string      `gorm:"not null"`
+	Filter    *TaskQuery  `gorm:"type:text; not null"`
+	UserID    UserID      `gorm:"not null; index"`
+	User      User        `gorm:"not null; foreignKey:UserID"`
+	CreatedAt time.Time   `gorm:"autoCreateTime"`
+}
+
+// Value は絞り込み条件を JSON 文字列として保存する。カーソルと件数は保存しない
+func (q TaskQuery) Value() (driver.Value, error) {
+	raw, err := json.Marshal(q)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (q *TaskQuery) Scan(value any) error {
+	var raw []byte
+	switch v := value.(type) {
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		return fmt.Errorf("Invalid type for TaskQuery: %T", value)
+	}
+	return json.Unmarshal(raw, q)
+}
@@ -0,0 +1,234 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	SearchText     SearchField = ""
+	SearchStatus   SearchField = "status"
+	SearchTag      SearchField = "tag"
+	SearchProject  SearchField = "project"
+	SearchPriority SearchField = "priority"
+	SearchDue      SearchField = "due"
+	SearchCreated  SearchField = "created"
+)
+
+const (
+	SearchEq SearchOperator = ""
+	SearchLt SearchOperator = "<"
+	SearchLe SearchOperator = "<="
+	SearchGt SearchOperator = ">"
+	SearchGe SearchOperator = ">="
+)
+
+// SearchNone は due:none のように値が無いことを表す
+const SearchNone = "none"
+
+var ErrInvalidSearchQuery = errors.New("Invalid search query")
+
+// SearchField は検索クエリで指定できるフィールド。SearchText はフィールド指定の無い語句
+type SearchField string
+
+func (f *SearchField) IsValid() bool {
+	switch *f {
+	case SearchStatus, SearchTag, SearchProject, SearchPriority, SearchDue, SearchCreated:
+		return true
+	}
+	return false
+}
+
+// isDate は比較演算子を使える日付のフィールドかどうか
+func (f SearchField) isDate() bool {
+	return f == SearchDue || f == SearchCreated
+}
+
+type SearchOperator string
+
+// SearchSyntaxError は検索クエリの解析エラー。Position はエラー箇所の 1 始まりの文字位置
+type SearchSyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *SearchSyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+func (e *SearchSyntaxError) Is(target error) bool {
+	return target == ErrInvalidSearchQuery
+}
+
+// SearchTerm は検索クエリの 1 つの条件。Date は日付のフィールドでのみ使い、none なら nil
+type SearchTerm struct {
+	Field    SearchField
+	Operator SearchOperator
+	Value    string
+	Date     *time.Time
+	Negated  bool
+}
+
+// TaskSearch は検索クエリを解析した結果。すべての条件を満たすタスクが対象になる
+type TaskSearch struct {
+	Terms []SearchTerm
+}
+
+// ParseTaskSearch は `status:inProgress due:<2025-02-01 tag:work "quarterly report"` のような検索クエリを解析する。
+// 語句は空白で区切り、field:value は条件、それ以外の語句や "..." は名前と説明の部分一致になる。
+// 先頭に - を付けると条件を否定する
+func ParseTaskSearch(query string) (*TaskSearch, error) {
+	p := searchParser{input: []rune(query)}
+	search := &TaskSearch{Terms: []SearchTerm{}}
+	for {
+		p.skipSpaces()
+		if p.done() {
+			return search, nil
+		}
+		term, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		search.Terms = append(search.Terms, *term)
+	}
+}
+
+type searchParser struct {
+	input []rune
+	pos   int
+}
+
+func (p *searchParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *searchParser) peek() rune {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *searchParser) skipSpaces() {
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// errorAt は 0 始まりの位置 pos のエラーを返す
+func (p *searchParser) errorAt(pos int, format string, args ...any) error {
+	return &SearchSyntaxError{Position: pos + 1, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *searchParser) parseTerm() (*SearchTerm, error) {
+	term := &SearchTerm{}
+	if p.peek() == '-' && p.pos+1 < len(p.input) && !unicode.IsSpace(p.input[p.pos+1]) {
+		term.Negated = true
+		p.pos++
+	}
+
+	start := p.pos
+	for !p.done() && unicode.IsLetter(p.peek()) {
+		p.pos++
+	}
+	if p.pos > start && p.peek() == ':' {
+		term.Field = SearchField(strings.ToLower(string(p.input[start:p.pos])))
+		if !term.Field.IsValid() {
+			return nil, p.errorAt(start, "unknown field %q", string(p.input[start:p.pos]))
+		}
+		p.pos++
+		return term, p.parseCondition(term)
+	}
+
+	p.pos = start
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, p.errorAt(start, "empty search text")
+	}
+	term.Value = value
+	return term, nil
+}
+
+// parseCondition は field: に続く演算子と値を解析し、フィールドごとに値を検証する
+func (p *searchParser) parseCondition(term *SearchTerm) error {
+	operatorStart := p.pos
+	for _, operator := range []SearchOperator{SearchLe, SearchGe, SearchLt, SearchGt} {
+		if strings.HasPrefix(string(p.input[p.pos:]), string(operator)) {
+			term.Operator = operator
+			p.pos += len(operator)
+			break
+		}
+	}
+	if term.Operator != SearchEq && !term.Field.isDate() {
+		return p.errorAt(operatorStart, "operator %s is not supported for field %s", term.Operator, term.Field)
+	}
+
+	valueStart := p.pos
+	value, err := p.parseValue()
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		return p.errorAt(valueStart, "missing value for field %s", term.Field)
+	}
+	term.Value = value
+
+	switch term.Field {
+	case SearchStatus:
+		if _, err := NewStatusName(value); err != nil {
+			return p.errorAt(valueStart, "invalid status %q", value)
+		}
+	case SearchPriority:
+		if _, err := NewPriority(value); err != nil {
+			return p.errorAt(valueStart, "invalid priority %q", value)
+		}
+	case SearchDue, SearchCreated:
+		if term.Field == SearchDue && value == SearchNone {
+			if term.Operator != SearchEq {
+				return p.errorAt(operatorStart, "operator %s cannot be used with none", term.Operator)
+			}
+			return nil
+		}
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return p.errorAt(valueStart, "invalid date %q, expected YYYY-MM-DD", value)
+		}
+		term.Date = &date
+	}
+	return nil
+}
+
+// parseValue は "..." で囲まれた値か、空白までの値を読む
+func (p *searchParser) parseValue() (string, error) {
+	if p.peek() != '"' {
+		start := p.pos
+		for !p.done() && !unicode.IsSpace(p.peek()) {
+			if p.peek() == '"' {
+				return "", p.errorAt(p.pos, "unexpected quote")
+			}
+			p.pos++
+		}
+		return string(p.input[start:p.pos]), nil
+	}
+
+	start := p.pos
+	p.pos++
+	for !p.done() && p.peek() != '"' {
+		p.pos++
+	}
+	if p.done() {
+		return "", p.errorAt(start, "unterminated quote")
+	}
+	value := string(p.input[start+1 : p.pos])
+	p.pos++
+	if !p.done() && !unicode.IsSpace(p.peek()) {
+		return "", p.errorAt(p.pos, "expected space after closing quote")
+	}
+	return value, nil
+}
@@ -0,0 +1,65 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTaskSearch(t *testing.T) {
+	date := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// test fields, operators, quoted text and negation are parsed in order
+	search, err := entity.ParseTaskSearch(`status:inProgress due:<2025-02-01 tag:work "quarterly report" -project:"Side Project" draft`)
+	assert.Nil(t, err)
+	assert.Equal(t, []entity.SearchTerm{
+		{Field: entity.SearchStatus, Value: "inProgress"},
+		{Field: entity.SearchDue, Operator: entity.SearchLt, Value: "2025-02-01", Date: &date},
+		{Field: entity.SearchTag, Value: "work"},
+		{Field: entity.SearchText, Value: "quarterly report"},
+		{Field: entity.SearchProject, Value: "Side Project", Negated: true},
+		{Field: entity.SearchText, Value: "draft"},
+	}, search.Terms)
+
+	// test due:none has no date
+	search, err = entity.ParseTaskSearch("due:none created:>=2025-02-01")
+	assert.Nil(t, err)
+	assert.Nil(t, search.Terms[0].Date)
+	assert.Equal(t, entity.SearchGe, search.Terms[1].Operator)
+
+	// test an empty query matches everything
+	search, err = entity.ParseTaskSearch("   ")
+	assert.Nil(t, err)
+	assert.Empty(t, search.Terms)
+}
+
+func TestParseTaskSearchErrors(t *testing.T) {
+	cases := []struct {
+		query    string
+		position int
+		message  string
+	}{
+		{`owner:me`, 1, `unknown field "owner"`},
+		{`tag:work status:doing`, 17, `invalid status "doing"`},
+		{`priority:critical`, 10, `invalid priority "critical"`},
+		{`due:2025-13-01`, 5, `invalid date "2025-13-01", expected YYYY-MM-DD`},
+		{`status:<todo`, 8, `operator < is not supported for field status`},
+		{`due:<none`, 5, `operator < cannot be used with none`},
+		{`tag:`, 5, `missing value for field tag`},
+		{`report "quarterly`, 8, `unterminated quote`},
+		{`"quarterly"report`, 12, `expected space after closing quote`},
+		{`quarter"ly`, 8, `unexpected quote`},
+	}
+	for _, c := range cases {
+		_, err := entity.ParseTaskSearch(c.query)
+		var syntaxErr *entity.SearchSyntaxError
+		if assert.True(t, errors.As(err, &syntaxErr), c.query) {
+			assert.Equal(t, c.position, syntaxErr.Position, c.query)
+			assert.Equal(t, c.message, syntaxErr.Message, c.query)
+		}
+		assert.ErrorIs(t, err, entity.ErrInvalidSearchQuery)
+	}
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+const (
+	AccessTokenLifetime  = 15 * time.Minute
+	RefreshTokenLifetime = 30 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("Invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("Refresh token has already been used")
+)
+
+type SessionID int
+
+// Session はログインした端末ごとのセッションで、リフレッシュトークンの系列をまとめる。
+// RevokedAt が設定されたセッションのリフレッシュトークンは使えない
+type Session struct {
+	ID         SessionID `gorm:"primaryKey"`
+	UserID     UserID    `gorm:"not null; index"`
+	User       User      `gorm:"not null; foreignKey:UserID"`
+	Device     string
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	LastUsedAt time.Time `gorm:"not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	RevokedAt  *time.Time
+}
+
+func NewSession(userID UserID, device string, now time.Time) *Session {
+	return &Session{
+		UserID:     userID,
+		Device:     device,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(RefreshTokenLifetime),
+	}
+}
+
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+type RefreshTokenID int
+
+// RefreshToken はリフレッシュトークンのハッシュ。使うたびに同じセッションの新しいトークンに交換し、
+// 使用済みのトークンがもう一度使われたら盗まれたものとしてセッションごと無効にする
+type RefreshToken struct {
+	ID        RefreshTokenID `gorm:"primaryKey"`
+	SessionID SessionID      `gorm:"not null; index"`
+	Session   Session        `gorm:"foreignKey:SessionID; constraint:OnDelete:CASCADE"`
+	TokenHash string         `gorm:"not null; uniqueIndex"`
+	ExpiresAt time.Time      `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// NewRefreshToken はランダムなトークンを作り、保存するハッシュと利用者に渡す平文を返す
+func NewRefreshToken(sessionID SessionID, now time.Time) (*RefreshToken, string, error) {
+	raw, err := NewRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	return &RefreshToken{
+		SessionID: sessionID,
+		TokenHash: HashToken(raw),
+		ExpiresAt: now.Add(RefreshTokenLifetime),
+	}, raw, nil
+}
+
+// NewRandomToken は URL に使える 256 bit のランダムな文字列を返す
+func NewRandomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken はトークンを保存するための SHA-256 のハッシュを返す。
+// トークンは十分にランダムなので、パスワードのような遅いハッシュは使わない
+func HashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// AuthTokens はログインやトークンの更新で発行するアクセストークンとリフレッシュトークン
+type AuthTokens struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	SessionID        SessionID
+}
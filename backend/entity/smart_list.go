@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	SmartListToday    SmartList = "today"
+	SmartListUpcoming SmartList = "upcoming"
+	SmartListOverdue  SmartList = "overdue"
+	SmartListSomeday  SmartList = "someday"
+)
+
+const (
+	DefaultUpcomingDays = 7
+	MaxUpcomingDays     = 90
+)
+
+var ErrInvalidUpcomingDays = errors.New("Upcoming days must be between 1 and 90")
+
+// SmartList は期限とステータスから求める仮想的なタスク一覧
+type SmartList string
+
+func (l *SmartList) IsValid() bool {
+	switch *l {
+	case SmartListToday, SmartListUpcoming, SmartListOverdue, SmartListSomeday:
+		return true
+	}
+	return false
+}
+
+func (l *SmartList) Set(value string) error {
+	newList := SmartList(value)
+	if !newList.IsValid() {
+		return errors.New("Invalid value for SmartList")
+	}
+	*l = newList
+	return nil
+}
+
+// SmartListQuery はスマートリストの取得条件。
+// Today はユーザーのタイムゾーンでの今日の日付を、期限日と同じく 0 時 (UTC) で表す。
+// Now は UTC で保存した期限の時刻と比べるため UTC で持つ
+type SmartListQuery struct {
+	List  SmartList
+	Days  int
+	Today time.Time
+	Now   time.Time
+}
+
+// NewSmartListQuery は location での now の日付を今日とする条件を作る。days は upcoming の日数で、0 ならデフォルト
+func NewSmartListQuery(list SmartList, days int, location *time.Location, now time.Time) (*SmartListQuery, error) {
+	if !list.IsValid() {
+		return nil, errors.New("Invalid value for SmartList")
+	}
+	if days == 0 {
+		days = DefaultUpcomingDays
+	}
+	if days < 1 || days > MaxUpcomingDays {
+		return nil, ErrInvalidUpcomingDays
+	}
+	local := now.In(location)
+	return &SmartListQuery{
+		List:  list,
+		Days:  days,
+		Today: time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC),
+		Now:   now.UTC(),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSmartListQuery(t *testing.T) {
+	// 2025-03-10 23:30 in UTC is already 2025-03-11 in Tokyo
+	now := time.Date(2025, 3, 10, 23, 30, 0, 0, time.UTC)
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	query, err := entity.NewSmartListQuery(entity.SmartListToday, 0, tokyo, now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC), query.Today)
+	assert.Equal(t, entity.DefaultUpcomingDays, query.Days)
+
+	// test now is kept in UTC even if it is given in another zone
+	query, err = entity.NewSmartListQuery(entity.SmartListToday, 0, tokyo, now.In(tokyo))
+	assert.Nil(t, err)
+	assert.Equal(t, time.UTC, query.Now.Location())
+	assert.True(t, query.Now.Equal(now))
+
+	query, err = entity.NewSmartListQuery(entity.SmartListUpcoming, 30, time.UTC, now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), query.Today)
+	assert.Equal(t, 30, query.Days)
+
+	_, err = entity.NewSmartListQuery(entity.SmartListUpcoming, entity.MaxUpcomingDays+1, time.UTC, now)
+	assert.ErrorIs(t, err, entity.ErrInvalidUpcomingDays)
+	_, err = entity.NewSmartListQuery(entity.SmartList("inbox"), 0, time.UTC, now)
+	assert.NotNil(t, err)
+}
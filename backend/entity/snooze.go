@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidSnooze = errors.New("Snooze time must be in the future")
+
+// IsSnoozed は now の時点でタスクがまだ開始日前か、スヌーズ中で一覧から隠すべきかを返す
+func (t *Task) IsSnoozed(now time.Time) bool {
+	return (t.StartAt != nil && t.StartAt.After(now)) ||
+		(t.SnoozedUntil != nil && t.SnoozedUntil.After(now))
+}
+
+// Snooze は until までタスクを一覧から隠す。until が nil ならスヌーズを解除してすぐに表示する
+func (t *Task) Snooze(until *time.Time, now time.Time) error {
+	if until == nil {
+		t.SnoozedUntil = nil
+		return nil
+	}
+	if !until.After(now) {
+		return ErrInvalidSnooze
+	}
+	snoozedUntil := until.UTC()
+	t.SnoozedUntil = &snoozedUntil
+	return nil
+}
@@ -0,0 +1,31 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnooze(t *testing.T) {
+	now := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	task := entity.Task{}
+	assert.False(t, task.IsSnoozed(now))
+
+	// test the task is hidden until the snooze ends
+	assert.Nil(t, task.Snooze(&later, now))
+	assert.True(t, task.IsSnoozed(now))
+	assert.False(t, task.IsSnoozed(later))
+
+	// test snoozing into the past is rejected and nil wakes the task up
+	assert.ErrorIs(t, task.Snooze(&now, now), entity.ErrInvalidSnooze)
+	assert.Nil(t, task.Snooze(nil, now))
+	assert.False(t, task.IsSnoozed(now))
+
+	// test a future start date hides the task as well
+	task.StartAt = &later
+	assert.True(t, task.IsSnoozed(now))
+	assert.False(t, task.IsSnoozed(later.Add(time.Minute)))
+}
@@ -10,6 +10,9 @@ const (
 	Pending    StatusName = "pending"
 )
 
+// StatusNames はかんばんの列の並び順
+var StatusNames = []StatusName{Todo, InProgress, Pending, Done, Archive}
+
 type StatusName string
 
 func NewStatusName(value string) (*StatusName, error) {
@@ -33,6 +36,16 @@ func (s *StatusName) Set(value string) error {
 	return nil
 }
 
+// Order はかんばんの列としての並び順を返す
+func (s StatusName) Order() int {
+	for i, name := range StatusNames {
+		if name == s {
+			return i
+		}
+	}
+	return len(StatusNames)
+}
+
 type StatusID int
 
 type Status struct {
@@ -15,3 +15,10 @@ func TestStatus(t *testing.T) {
 	assert.Equal(t, entity.StatusID(1), status.ID)
 	assert.Equal(t, entity.StatusName("todo"), status.Name)
 }
+
+func TestStatusNameOrder(t *testing.T) {
+	assert.Less(t, entity.Todo.Order(), entity.InProgress.Order())
+	assert.Less(t, entity.InProgress.Order(), entity.Pending.Order())
+	assert.Less(t, entity.Pending.Order(), entity.Done.Order())
+	assert.Less(t, entity.Done.Order(), entity.Archive.Order())
+}
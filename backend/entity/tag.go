@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrTagNotFound = errors.New("Tag not found")
+
+type TagID int
+
+type Tag struct {
+	ID        TagID     `gorm:"primaryKey"`
+	Name      string    `gorm:"not null; uniqueIndex:idx_tags_user_id_name"`
+	UserID    UserID    `gorm:"not null; uniqueIndex:idx_tags_user_id_name"`
+	User      User      `gorm:"not null; foreignKey:UserID"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
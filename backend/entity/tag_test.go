@@ -0,0 +1,29 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"backend/pkg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTag(t *testing.T) {
+	now := pkg.Str2time("2025-01-01")
+	tag := entity.Tag{
+		ID:        1,
+		Name:      "work",
+		UserID:    1,
+		CreatedAt: now,
+	}
+	assert.Equal(t, entity.TagID(1), tag.ID)
+	assert.Equal(t, "work", tag.Name)
+	assert.Equal(t, entity.UserID(1), tag.UserID)
+	assert.Equal(t, now, tag.CreatedAt)
+
+	task := entity.Task{
+		ID:   1,
+		Tags: []entity.Tag{tag},
+	}
+	assert.Equal(t, entity.TagID(1), task.Tags[0].ID)
+}
@@ -1,16 +1,161 @@
 package entity
 
-import "time"
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ClearDescription ClearField = "description"
+	ClearRecurrence  ClearField = "recurrence"
+	ClearDeadline    ClearField = "deadline"
+	ClearEstimate    ClearField = "estimate"
+	ClearStartAt     ClearField = "startAt"
+)
+
+var (
+	ErrTaskNotFound  = errors.New("Task not found")
+	ErrClearConflict = errors.New("Cannot set and clear the same field")
+)
 
 type TaskID int
 
 type Task struct {
-	ID        TaskID   `gorm:"primaryKey"`
-	Name      string   `gorm:"not null"`
-	StatusID  StatusID `gorm:"not null"`
-	Status    Status   `gorm:"not null; foreignKey:StatusID"`
-	UserID    UserID   `gorm:"not null"`
-	User      User     `gorm:"not null; foreignKey:UserID"`
-	Deadline  *time.Time
-	CreatedAt time.Time `gorm:"autoCreateTime"`
+	ID             TaskID           `gorm:"primaryKey"`
+	Name           string           `gorm:"not null"`
+	Description    string           `gorm:"type:text"`
+	Priority       Priority         `gorm:"not null;default:none"`
+	StatusID       StatusID         `gorm:"not null"`
+	Status         Status           `gorm:"not null; foreignKey:StatusID"`
+	Rank           Rank             `gorm:"not null; default:''; index"`
+	ProjectID      *ProjectID       `gorm:"index"`
+	Project        *Project         `gorm:"foreignKey:ProjectID; constraint:OnDelete:SET NULL"`
+	UserID         UserID           `gorm:"not null"`
+	User           User             `gorm:"not null; foreignKey:UserID"`
+	Tags           []Tag            `gorm:"many2many:task_tags; constraint:OnDelete:CASCADE"`
+	ChecklistItems []ChecklistItem  `gorm:"foreignKey:TaskID; constraint:OnDelete:CASCADE"`
+	Blockers       []TaskDependency `gorm:"foreignKey:TaskID; constraint:OnDelete:CASCADE"`
+	CommentCount   int              `gorm:"->; -:migration"`
+	TrackedSeconds int64            `gorm:"->; -:migration"`
+	// SearchRank は全文検索の関連度、Highlighted* は一致した語を <mark> で囲んだ HTML。全文検索の結果でのみ設定する
+	SearchRank             float64         `gorm:"->; -:migration"`
+	HighlightedName        string          `gorm:"->; -:migration"`
+	HighlightedDescription string          `gorm:"->; -:migration"`
+	Recurrence             *RecurrenceRule `gorm:"type:text"`
+	// Deadline は期限日 (0 時 UTC)。DueAt は時刻付きの期限を UTC で表し、終日の期限なら nil。
+	// TimeZone は期限を指定した IANA タイムゾーン名
+	Deadline *time.Time
+	DueAt    *time.Time
+	TimeZone string
+	Estimate *Estimate `gorm:"embedded; embeddedPrefix:estimate_"`
+	// StartAt は着手できるようになる日時、SnoozedUntil はスヌーズの終了日時。
+	// どちらかが未来のタスクは一覧から隠し、その日時を過ぎると自動で再び表示する
+	StartAt      *time.Time `gorm:"index"`
+	SnoozedUntil *time.Time `gorm:"index"`
+	// WorkStartedAt は初めて着手した日時、CompletedAt は完了した日時。見積もりと実績の比較に使う
+	WorkStartedAt *time.Time
+	CompletedAt   *time.Time `gorm:"index"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+	// DeletedAt が設定されたタスクはゴミ箱にあり、復元するか完全に削除するまで残る
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// GORM は見積もりの列が NULL でも埋め込みの見積もりに空の値を割り当てるため、読み込みと保存の後に nil に戻す
+func (t *Task) AfterFind(tx *gorm.DB) error {
+	t.clearEmptyEstimate()
+	return nil
+}
+
+func (t *Task) AfterSave(tx *gorm.DB) error {
+	t.clearEmptyEstimate()
+	return nil
+}
+
+func (t *Task) clearEmptyEstimate() {
+	if t.Estimate != nil && t.Estimate.Unit == "" {
+		t.Estimate = nil
+	}
+}
+
+// TrackCycle はステータスが from から変わったときに着手日時と完了日時を記録する。
+// 着手日時は最初に着手したときのまま残す。完了日時はアーカイブしても残し、未完了の列に戻すと消す
+func (t *Task) TrackCycle(from StatusName, now time.Time) {
+	if from == t.Status.Name {
+		return
+	}
+	switch t.Status.Name {
+	case InProgress:
+		if t.WorkStartedAt == nil {
+			t.WorkStartedAt = &now
+		}
+		t.CompletedAt = nil
+	case Done:
+		t.CompletedAt = &now
+	case Archive:
+	default:
+		t.CompletedAt = nil
+	}
+}
+
+// ClearField は更新で値を消すタスクの項目。更新では省略した項目を変更しないため、値を消すときに指定する
+type ClearField string
+
+func NewClearField(value string) (*ClearField, error) {
+	field := ClearField(value)
+	if !field.IsValid() {
+		return nil, errors.New("Invalid value for ClearField")
+	}
+	return &field, nil
+}
+
+func (f *ClearField) IsValid() bool {
+	return *f == ClearDescription || *f == ClearRecurrence || *f == ClearDeadline || *f == ClearEstimate || *f == ClearStartAt
+}
+
+// CheckClear は値を指定した項目を同じ更新で消そうとしていないか確認する
+func (t *Task) CheckClear(fields []ClearField) error {
+	for _, field := range fields {
+		if t.hasValue(field) {
+			return ErrClearConflict
+		}
+	}
+	return nil
+}
+
+func (t *Task) hasValue(field ClearField) bool {
+	switch field {
+	case ClearDescription:
+		return t.Description != ""
+	case ClearRecurrence:
+		return t.Recurrence != nil
+	case ClearDeadline:
+		return t.Deadline != nil
+	case ClearEstimate:
+		return t.Estimate != nil
+	case ClearStartAt:
+		return t.StartAt != nil
+	}
+	return false
+}
+
+// Clear は fields の項目の値を消す。期限を消すときは時刻とタイムゾーンも消す
+func (t *Task) Clear(fields []ClearField) {
+	for _, field := range fields {
+		switch field {
+		case ClearDescription:
+			t.Description = ""
+		case ClearRecurrence:
+			t.Recurrence = nil
+		case ClearDeadline:
+			t.Deadline = nil
+			t.DueAt = nil
+			t.TimeZone = ""
+		case ClearEstimate:
+			t.Estimate = nil
+		case ClearStartAt:
+			t.StartAt = nil
+		}
+	}
 }
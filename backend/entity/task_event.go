@@ -0,0 +1,176 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	TaskCreated  TaskEventType = "created"
+	TaskUpdated  TaskEventType = "updated"
+	TaskDeleted  TaskEventType = "deleted"
+	TaskRestored TaskEventType = "restored"
+)
+
+const (
+	DefaultTaskEventLimit = 50
+	MaxTaskEventLimit     = 200
+)
+
+type TaskEventID int
+
+type TaskEventType string
+
+// FieldChange は 1 つのフィールドの変更前後の値。値が無い場合は nil
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// FieldChanges は JSON 文字列として保存する
+type FieldChanges []FieldChange
+
+func (c FieldChanges) Value() (driver.Value, error) {
+	if c == nil {
+		c = FieldChanges{}
+	}
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+func (c *FieldChanges) Scan(value any) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*c = FieldChanges{}
+		return nil
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		return fmt.Errorf("Invalid type for FieldChanges: %T", value)
+	}
+	return json.Unmarshal(raw, c)
+}
+
+// TaskEvent はタスクに対する操作の履歴。UserID は操作したユーザー
+type TaskEvent struct {
+	ID        TaskEventID   `gorm:"primaryKey"`
+	TaskID    TaskID        `gorm:"not null; index"`
+	UserID    UserID        `gorm:"not null"`
+	Type      TaskEventType `gorm:"not null"`
+	Changes   FieldChanges  `gorm:"type:text"`
+	CreatedAt time.Time     `gorm:"autoCreateTime"`
+}
+
+func NewTaskEvent(eventType TaskEventType, task *Task, changes FieldChanges) *TaskEvent {
+	return &TaskEvent{
+		TaskID:  task.ID,
+		UserID:  task.UserID,
+		Type:    eventType,
+		Changes: changes,
+	}
+}
+
+// taskFields は履歴に記録するフィールドと、その値の取り出し方
+var taskFields = []struct {
+	name  string
+	value func(task *Task) any
+}{
+	{"name", func(task *Task) any { return task.Name }},
+	{"description", func(task *Task) any { return task.Description }},
+	{"priority", func(task *Task) any { return string(task.Priority) }},
+	{"status", func(task *Task) any { return string(task.Status.Name) }},
+	{"rank", func(task *Task) any { return string(task.Rank) }},
+	{"projectId", func(task *Task) any {
+		if task.ProjectID == nil {
+			return nil
+		}
+		return int(*task.ProjectID)
+	}},
+	{"tagIds", func(task *Task) any {
+		tagIDs := make([]int, len(task.Tags))
+		for i, tag := range task.Tags {
+			tagIDs[i] = int(tag.ID)
+		}
+		slices.Sort(tagIDs)
+		return tagIDs
+	}},
+	{"recurrence", func(task *Task) any {
+		if task.Recurrence == nil {
+			return nil
+		}
+		return task.Recurrence.String()
+	}},
+	{"deadline", func(task *Task) any { return timeFieldValue(task.Deadline) }},
+	{"dueAt", func(task *Task) any { return timeFieldValue(task.DueAt) }},
+	{"timeZone", func(task *Task) any { return task.TimeZone }},
+	{"estimate", func(task *Task) any {
+		if task.Estimate == nil {
+			return nil
+		}
+		return task.Estimate.String()
+	}},
+	{"startAt", func(task *Task) any { return timeFieldValue(task.StartAt) }},
+	{"snoozedUntil", func(task *Task) any { return timeFieldValue(task.SnoozedUntil) }},
+}
+
+// timeFieldValue は日時のフィールドを履歴に記録する UTC の RFC 3339 形式にする
+func timeFieldValue(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// DiffTasks は before から after への変更をフィールドごとに返す。変更がなければ空
+func DiffTasks(before *Task, after *Task) FieldChanges {
+	changes := FieldChanges{}
+	for _, field := range taskFields {
+		beforeValue, afterValue := field.value(before), field.value(after)
+		if !equalFieldValues(beforeValue, afterValue) {
+			changes = append(changes, FieldChange{Field: field.name, Before: beforeValue, After: afterValue})
+		}
+	}
+	return changes
+}
+
+func equalFieldValues(a any, b any) bool {
+	rawA, _ := json.Marshal(a)
+	rawB, _ := json.Marshal(b)
+	return string(rawA) == string(rawB)
+}
+
+// TaskEventQuery はタスクの履歴取得時のページングの条件
+type TaskEventQuery struct {
+	Cursor string
+	Limit  int
+}
+
+func NewTaskEventQuery() *TaskEventQuery {
+	return &TaskEventQuery{Limit: DefaultTaskEventLimit}
+}
+
+// Normalize は範囲外の件数をデフォルトに揃える
+func (q *TaskEventQuery) Normalize() {
+	if q.Limit <= 0 {
+		q.Limit = DefaultTaskEventLimit
+	}
+	if q.Limit > MaxTaskEventLimit {
+		q.Limit = MaxTaskEventLimit
+	}
+}
+
+// TaskEventPage は古い順に並んだ履歴の 1 ページ。NextCursor が nil なら最終ページ
+type TaskEventPage struct {
+	Events     []TaskEvent
+	NextCursor *string
+}
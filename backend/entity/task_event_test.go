@@ -0,0 +1,52 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"backend/pkg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTasks(t *testing.T) {
+	projectID := entity.ProjectID(1)
+	deadline := pkg.Str2time("2025-01-31")
+	before := entity.Task{
+		Name:     "Task",
+		Priority: entity.PriorityLow,
+		Status:   entity.Status{Name: entity.Todo},
+		Tags:     []entity.Tag{{ID: 2}, {ID: 1}},
+	}
+	after := before
+	after.Name = "Renamed"
+	after.Status = entity.Status{Name: entity.Done}
+	after.ProjectID = &projectID
+	after.Tags = []entity.Tag{{ID: 1}, {ID: 2}}
+	after.Deadline = &deadline
+
+	assert.Equal(t, entity.FieldChanges{
+		{Field: "name", Before: "Task", After: "Renamed"},
+		{Field: "status", Before: "todo", After: "done"},
+		{Field: "projectId", Before: nil, After: 1},
+		{Field: "deadline", Before: nil, After: "2025-01-31T00:00:00Z"},
+	}, entity.DiffTasks(&before, &after))
+
+	assert.Empty(t, entity.DiffTasks(&before, &before))
+}
+
+func TestFieldChangesScan(t *testing.T) {
+	changes := entity.FieldChanges{{Field: "name", Before: "Task", After: "Renamed"}}
+	value, err := changes.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"field":"name","before":"Task","after":"Renamed"}]`, value)
+
+	var scanned entity.FieldChanges
+	assert.Nil(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, changes, scanned)
+
+	value, err = entity.FieldChanges(nil).Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", value)
+
+	assert.NotNil(t, scanned.Scan(1))
+}
@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	SortByCreatedAt TaskSortField = "createdAt"
+	SortByDeadline  TaskSortField = "deadline"
+	SortByName      TaskSortField = "name"
+	SortByPriority  TaskSortField = "priority"
+	// SortByPosition はステータス列の順、列の中では手動で並べた順
+	SortByPosition TaskSortField = "position"
+	// SortByRelevance は全文検索の関連度順。Text を指定したときだけ使える
+	SortByRelevance TaskSortField = "relevance"
+)
+
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+const (
+	DefaultTaskLimit = 50
+	MaxTaskLimit     = 200
+	MaxDueWithinDays = 366
+)
+
+var (
+	ErrInvalidCursor        = errors.New("Invalid cursor")
+	ErrInvalidSearchText    = errors.New("Search text must contain at least one word")
+	ErrInvalidDueWithinDays = errors.New("dueWithinDays must be between 1 and 366")
+)
+
+type TaskSortField string
+
+func (f *TaskSortField) IsValid() bool {
+	return *f == SortByCreatedAt || *f == SortByDeadline || *f == SortByName || *f == SortByPriority || *f == SortByPosition ||
+		*f == SortByRelevance
+}
+
+func (f *TaskSortField) Set(value string) error {
+	newSortField := TaskSortField(value)
+	if !newSortField.IsValid() {
+		return errors.New("Invalid value for TaskSortField")
+	}
+	*f = newSortField
+	return nil
+}
+
+type SortOrder string
+
+func (o *SortOrder) IsValid() bool {
+	return *o == Asc || *o == Desc
+}
+
+func (o *SortOrder) Set(value string) error {
+	newSortOrder := SortOrder(value)
+	if !newSortOrder.IsValid() {
+		return errors.New("Invalid value for SortOrder")
+	}
+	*o = newSortOrder
+	return nil
+}
+
+// TaskQuery はタスク一覧取得時の絞り込み・並び替え・ページングの条件。
+// DueWithinDays は今日から何日以内が期限かで絞り込む相対的な範囲で、保存したビューの取得時に ResolveDueWithin で期限日の範囲にする
+type TaskQuery struct {
+	Statuses       []StatusName  `json:"statuses,omitempty"`
+	Priorities     []Priority    `json:"priorities,omitempty"`
+	TagIDs         []TagID       `json:"tagIds,omitempty"`
+	ProjectID      *ProjectID    `json:"projectId,omitempty"`
+	Inbox          bool          `json:"inbox,omitempty"`
+	DeadlineFrom   *time.Time    `json:"deadlineFrom,omitempty"`
+	DeadlineTo     *time.Time    `json:"deadlineTo,omitempty"`
+	DueWithinDays  *int          `json:"dueWithinDays,omitempty"`
+	IncludeSnoozed bool          `json:"includeSnoozed,omitempty"`
+	SortBy         TaskSortField `json:"sortBy,omitempty"`
+	Order          SortOrder     `json:"order,omitempty"`
+	Text           string        `json:"text,omitempty"`
+	Search         *TaskSearch   `json:"-"`
+	Cursor         string        `json:"-"`
+	Limit          int           `json:"-"`
+}
+
+func NewTaskQuery() *TaskQuery {
+	return &TaskQuery{
+		SortBy: SortByPosition,
+		Order:  Asc,
+		Limit:  DefaultTaskLimit,
+	}
+}
+
+// Normalize は未指定・範囲外の値をデフォルトに揃え、不正な値があればエラーを返す
+func (q *TaskQuery) Normalize() error {
+	if q.SortBy == "" {
+		q.SortBy = SortByPosition
+	}
+	if !q.SortBy.IsValid() {
+		return errors.New("Invalid value for TaskSortField")
+	}
+	if q.Order == "" {
+		q.Order = Asc
+	}
+	if !q.Order.IsValid() {
+		return errors.New("Invalid value for SortOrder")
+	}
+	if q.ProjectID != nil && q.Inbox {
+		return errors.New("projectId and inbox cannot be combined")
+	}
+	for _, status := range q.Statuses {
+		if !status.IsValid() {
+			return errors.New("Invalid value for StatusName")
+		}
+	}
+	for _, priority := range q.Priorities {
+		if !priority.IsValid() {
+			return errors.New("Invalid value for Priority")
+		}
+	}
+	if q.DueWithinDays != nil && (*q.DueWithinDays < 1 || *q.DueWithinDays > MaxDueWithinDays) {
+		return ErrInvalidDueWithinDays
+	}
+	if q.Text != "" && len(q.Words()) == 0 {
+		return ErrInvalidSearchText
+	}
+	if q.SortBy == SortByRelevance && q.Text == "" {
+		return errors.New("relevance sort requires text")
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultTaskLimit
+	}
+	if q.Limit > MaxTaskLimit {
+		q.Limit = MaxTaskLimit
+	}
+	return nil
+}
+
+// ResolveDueWithin は DueWithinDays を location での今日から始まる期限日の範囲にする。
+// DeadlineFrom や DeadlineTo も指定されていれば、両方の条件を満たす範囲にする
+func (q *TaskQuery) ResolveDueWithin(now time.Time, location *time.Location) {
+	if q.DueWithinDays == nil {
+		return
+	}
+	local := now.In(location)
+	from := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, *q.DueWithinDays-1)
+	if q.DeadlineFrom == nil || q.DeadlineFrom.Before(from) {
+		q.DeadlineFrom = &from
+	}
+	if q.DeadlineTo == nil || q.DeadlineTo.After(to) {
+		q.DeadlineTo = &to
+	}
+}
+
+// Words は全文検索の語を小文字で返す。英数字以外の文字で区切り、すべての語を含むタスクが対象になる
+func (q *TaskQuery) Words() []string {
+	return strings.FieldsFunc(strings.ToLower(q.Text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// TaskPage はページングされたタスク一覧。NextCursor が nil なら最終ページ
+type TaskPage struct {
+	Tasks      []Task
+	NextCursor *string
+}
@@ -0,0 +1,74 @@
+package entity_test
+
+import (
+	"backend/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskQuery(t *testing.T) {
+	query := entity.TaskQuery{Limit: 1000}
+	assert.Nil(t, query.Normalize())
+	assert.Equal(t, entity.SortByPosition, query.SortBy)
+	assert.Equal(t, entity.Asc, query.Order)
+	assert.Equal(t, entity.MaxTaskLimit, query.Limit)
+
+	query = entity.TaskQuery{}
+	assert.Nil(t, query.Normalize())
+	assert.Equal(t, entity.DefaultTaskLimit, query.Limit)
+
+	query = entity.TaskQuery{SortBy: "unknown"}
+	assert.NotNil(t, query.Normalize())
+
+	query = entity.TaskQuery{Statuses: []entity.StatusName{"unknown"}}
+	assert.NotNil(t, query.Normalize())
+
+	projectID := entity.ProjectID(1)
+	query = entity.TaskQuery{ProjectID: &projectID, Inbox: true}
+	assert.NotNil(t, query.Normalize())
+
+	query = entity.TaskQuery{Text: " -- "}
+	assert.ErrorIs(t, query.Normalize(), entity.ErrInvalidSearchText)
+
+	query = entity.TaskQuery{SortBy: entity.SortByRelevance}
+	assert.NotNil(t, query.Normalize())
+
+	query = entity.TaskQuery{Priorities: []entity.Priority{"unknown"}}
+	assert.NotNil(t, query.Normalize())
+
+	for _, days := range []int{0, entity.MaxDueWithinDays + 1} {
+		query = entity.TaskQuery{DueWithinDays: &days}
+		assert.ErrorIs(t, query.Normalize(), entity.ErrInvalidDueWithinDays)
+	}
+}
+
+func TestTaskQueryResolveDueWithin(t *testing.T) {
+	// 2025-03-10 23:30 in UTC is already 2025-03-11 in Tokyo
+	now := time.Date(2025, 3, 10, 23, 30, 0, 0, time.UTC)
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	days := 7
+
+	query := entity.TaskQuery{DueWithinDays: &days}
+	query.ResolveDueWithin(now, tokyo)
+	assert.Equal(t, time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC), *query.DeadlineFrom)
+	assert.Equal(t, time.Date(2025, 3, 17, 0, 0, 0, 0, time.UTC), *query.DeadlineTo)
+
+	// test an absolute range narrows the relative one
+	to := time.Date(2025, 3, 13, 0, 0, 0, 0, time.UTC)
+	query = entity.TaskQuery{DueWithinDays: &days, DeadlineTo: &to}
+	query.ResolveDueWithin(now, time.UTC)
+	assert.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), *query.DeadlineFrom)
+	assert.Equal(t, to, *query.DeadlineTo)
+
+	query = entity.TaskQuery{}
+	query.ResolveDueWithin(now, time.UTC)
+	assert.Nil(t, query.DeadlineFrom)
+	assert.Nil(t, query.DeadlineTo)
+}
+
+func TestTaskQueryWords(t *testing.T) {
+	query := entity.TaskQuery{Text: "Quarterly-report, Q3 café"}
+	assert.Equal(t, []string{"quarterly", "report", "q3", "café"}, query.Words())
+}
@@ -41,3 +41,17 @@ func TestTask(t *testing.T) {
 	assert.Equal(t, "password", task.User.Password)
 	assert.Equal(t, now, task.User.CreatedAt)
 }
+
+func TestTaskClear(t *testing.T) {
+	_, err := entity.NewClearField("name")
+	assert.NotNil(t, err)
+
+	startAt := pkg.Str2time("2025-01-01")
+	task := entity.Task{StartAt: &startAt, Estimate: &entity.Estimate{Value: 1, Unit: entity.EstimatePoints}}
+	assert.Nil(t, task.CheckClear([]entity.ClearField{entity.ClearRecurrence, entity.ClearDeadline}))
+	assert.ErrorIs(t, task.CheckClear([]entity.ClearField{entity.ClearStartAt}), entity.ErrClearConflict)
+
+	task.Clear([]entity.ClearField{entity.ClearStartAt})
+	assert.Nil(t, task.StartAt)
+	assert.NotNil(t, task.Estimate)
+}
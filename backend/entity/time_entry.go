@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrTimeEntryNotFound   = errors.New("Time entry not found")
+	ErrTimerAlreadyRunning = errors.New("Timer is already running")
+	ErrTimerNotRunning     = errors.New("Timer is not running")
+	ErrInvalidTimeRange    = errors.New("Time entry cannot end before it starts")
+)
+
+type TimeEntryID int
+
+// TimeEntry はタスクに費やした作業時間の記録。EndedAt が nil の記録は計測中のタイマーで、ユーザーごとに 1 つまで。
+// DurationSeconds は終了時に計算し、計測中は 0 とする
+type TimeEntry struct {
+	ID              TimeEntryID `gorm:"primaryKey"`
+	TaskID          TaskID      `gorm:"not null; index"`
+	UserID          UserID      `gorm:"not null; index; uniqueIndex:idx_time_entries_running,where:ended_at IS NULL"`
+	StartedAt       time.Time   `gorm:"not null"`
+	EndedAt         *time.Time
+	DurationSeconds int64     `gorm:"not null; default:0"`
+	Note            string    `gorm:"type:text"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+func (e *TimeEntry) IsRunning() bool {
+	return e.EndedAt == nil
+}
+
+// Finish は endedAt で記録を終了し、作業時間を計算する。endedAt が開始より前なら ErrInvalidTimeRange
+func (e *TimeEntry) Finish(endedAt time.Time) error {
+	if endedAt.Before(e.StartedAt) {
+		return ErrInvalidTimeRange
+	}
+	e.EndedAt = &endedAt
+	e.DurationSeconds = int64(endedAt.Sub(e.StartedAt) / time.Second)
+	return nil
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// RevokedToken はログアウトなどで無効にしたアクセストークンの jti。
+// 有効期限を過ぎたトークンはそもそも使えないので、ExpiresAt を過ぎたら削除してよい
+type RevokedToken struct {
+	JTI       string    `gorm:"primaryKey"`
+	ExpiresAt time.Time `gorm:"not null; index"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -1,6 +1,11 @@
 package entity
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var ErrUserNotFound = errors.New("User not found")
 
 type UserID int
 
@@ -8,5 +13,23 @@ type User struct {
 	ID        UserID `gorm:"primaryKey"`
 	Email     string `gorm:"unique"`
 	Password  string
+	TimeZone  string
 	CreatedAt time.Time `gorm:"autoCreateTime"`
+	// EmailVerifiedAt はメールアドレスを確認した日時。未確認なら nil
+	EmailVerifiedAt *time.Time
+	// VerificationSentAt は最後に確認メールを送った日時で、再送の間隔を制限するのに使う
+	VerificationSentAt *time.Time
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// Location は日付の計算に使うユーザーの IANA タイムゾーンを返す。未設定か読み込めなければ UTC
+func (u *User) Location() *time.Location {
+	location, err := LoadTimeZone(u.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
 }
@@ -4,6 +4,7 @@ import (
 	"backend/entity"
 	"backend/pkg"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -22,3 +23,9 @@ func TestUser(t *testing.T) {
 	assert.Equal(t, "password", user.Password)
 	assert.Equal(t, now, user.CreatedAt)
 }
+
+func TestUserLocation(t *testing.T) {
+	assert.Equal(t, time.UTC, (&entity.User{}).Location())
+	assert.Equal(t, "Asia/Tokyo", (&entity.User{TimeZone: "Asia/Tokyo"}).Location().String())
+	assert.Equal(t, time.UTC, (&entity.User{TimeZone: "Nowhere/City"}).Location())
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Migrate はテーブルを作成・更新し、全文検索の列やインデックスを用意する
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(entity.NewDomains()...); err != nil {
+		return err
+	}
+	return setupTaskSearch(db)
+}
+
+// setupTaskSearch はタスクの全文検索に使う列やインデックスを作る。AutoMigrate の後に呼ぶ。
+// Postgres では名前と説明の tsvector を生成列にして GIN インデックスを張り、
+// SQLite では FTS5 の仮想テーブルをトリガーで同期する。FTS5 が使えない SQLite では何もしない
+func setupTaskSearch(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("ALTER TABLE tasks ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (" +
+				"setweight(to_tsvector('simple', COALESCE(name, '')), 'A') || " +
+				"setweight(to_tsvector('simple', COALESCE(description, '')), 'B')) STORED").Error; err != nil {
+				return err
+			}
+			return tx.Exec("CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING GIN (search_vector)").Error
+		})
+	case "sqlite":
+		if exists, err := gateway.HasFTS5Table(db); err != nil || exists {
+			return err
+		}
+		if err := db.Exec("CREATE VIRTUAL TABLE tasks_fts USING fts5(name, description, content='tasks', content_rowid='id')").Error; err != nil {
+			if strings.Contains(err.Error(), "no such module") {
+				// FTS5 を含まずにビルドした場合は LIKE による検索で代用する
+				return nil
+			}
+			return err
+		}
+		return db.Transaction(func(tx *gorm.DB) error {
+			for _, statement := range []string{
+				"CREATE TRIGGER tasks_fts_insert AFTER INSERT ON tasks BEGIN " +
+					"INSERT INTO tasks_fts(rowid, name, description) VALUES (new.id, new.name, COALESCE(new.description, '')); END",
+				"CREATE TRIGGER tasks_fts_delete AFTER DELETE ON tasks BEGIN " +
+					"INSERT INTO tasks_fts(tasks_fts, rowid, name, description) VALUES ('delete', old.id, old.name, COALESCE(old.description, '')); END",
+				"CREATE TRIGGER tasks_fts_update AFTER UPDATE OF name, description ON tasks BEGIN " +
+					"INSERT INTO tasks_fts(tasks_fts, rowid, name, description) VALUES ('delete', old.id, old.name, COALESCE(old.description, '')); " +
+					"INSERT INTO tasks_fts(rowid, name, description) VALUES (new.id, new.name, COALESCE(new.description, '')); END",
+				// 既にあるタスクを索引に入れる
+				"INSERT INTO tasks_fts(tasks_fts) VALUES ('rebuild')",
+			} {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return nil
+}
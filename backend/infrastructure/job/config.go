@@ -0,0 +1,55 @@
+package job
+
+import (
+	"strconv"
+	"time"
+
+	"backend/pkg"
+	"backend/pkg/logger"
+)
+
+const (
+	defaultTrashRetentionDays = 30
+	defaultTrashPurgeInterval = time.Hour
+
+	defaultTokenRevocationPruneInterval = time.Hour
+)
+
+type Config struct {
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+
+	TokenRevocationPruneInterval time.Duration
+}
+
+func NewConfigJob() *Config {
+	config := &Config{
+		TrashRetention:     defaultTrashRetentionDays * 24 * time.Hour,
+		TrashPurgeInterval: defaultTrashPurgeInterval,
+
+		TokenRevocationPruneInterval: defaultTokenRevocationPruneInterval,
+	}
+
+	retentionDays := pkg.GetEnvDefault("TRASH_RETENTION_DAYS", strconv.Itoa(defaultTrashRetentionDays))
+	if days, err := strconv.Atoi(retentionDays); err == nil && days > 0 {
+		config.TrashRetention = time.Duration(days) * 24 * time.Hour
+	} else {
+		logger.Warn("Invalid TRASH_RETENTION_DAYS, using default: " + retentionDays)
+	}
+
+	purgeInterval := pkg.GetEnvDefault("TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval.String())
+	if interval, err := time.ParseDuration(purgeInterval); err == nil && interval > 0 {
+		config.TrashPurgeInterval = interval
+	} else {
+		logger.Warn("Invalid TRASH_PURGE_INTERVAL, using default: " + purgeInterval)
+	}
+
+	pruneInterval := pkg.GetEnvDefault("TOKEN_REVOCATION_PRUNE_INTERVAL", defaultTokenRevocationPruneInterval.String())
+	if interval, err := time.ParseDuration(pruneInterval); err == nil && interval > 0 {
+		config.TokenRevocationPruneInterval = interval
+	} else {
+		logger.Warn("Invalid TOKEN_REVOCATION_PRUNE_INTERVAL, using default: " + pruneInterval)
+	}
+
+	return config
+}
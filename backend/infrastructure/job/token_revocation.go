@@ -0,0 +1,50 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"backend/adapter/gateway"
+	"backend/pkg/logger"
+)
+
+// TokenRevocationPruner は有効期限を過ぎたトークンの無効化の記録を定期的に削除する
+type TokenRevocationPruner struct {
+	store    gateway.ITokenRevocationStore
+	interval time.Duration
+}
+
+func NewTokenRevocationPruner(db *gorm.DB, config *Config) *TokenRevocationPruner {
+	return &TokenRevocationPruner{
+		store:    gateway.NewTokenRevocationRepository(db),
+		interval: config.TokenRevocationPruneInterval,
+	}
+}
+
+// Run は ctx がキャンセルされるまで、起動時と interval ごとに記録を掃除する
+func (p *TokenRevocationPruner) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.prune()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TokenRevocationPruner) prune() {
+	pruned, err := p.store.Prune(time.Now())
+	if err != nil {
+		logger.Error("Failed to prune token revocations: " + err.Error())
+		return
+	}
+	if pruned > 0 {
+		logger.Info("Pruned expired token revocations", "count", pruned)
+	}
+}
@@ -0,0 +1,53 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"backend/adapter/gateway"
+	"backend/pkg/logger"
+	"backend/usecase"
+)
+
+// TrashPurger は保持期間を過ぎたゴミ箱のタスクを定期的に完全に削除する
+type TrashPurger struct {
+	tu        usecase.ITaskUsecase
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewTrashPurger(db *gorm.DB, blobStorage gateway.IBlobStorage, config *Config) *TrashPurger {
+	return &TrashPurger{
+		tu:        usecase.NewTaskUsecase(gateway.NewTaskRepository(db), gateway.NewTaskEventRepository(db), gateway.NewAttachmentRepository(db), blobStorage, gateway.NewTimeEntryRepository(db)),
+		retention: config.TrashRetention,
+		interval:  config.TrashPurgeInterval,
+	}
+}
+
+// Run は ctx がキャンセルされるまで、起動時と interval ごとにゴミ箱を掃除する
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) purge() {
+	purged, err := p.tu.PurgeTrash(p.retention)
+	if err != nil {
+		logger.Error("Failed to purge trash: " + err.Error())
+		return
+	}
+	if purged > 0 {
+		logger.Info("Purged tasks from the trash", "count", purged)
+	}
+}
@@ -0,0 +1,65 @@
+package mail
+
+import (
+	"errors"
+	"time"
+
+	"backend/entity"
+	"backend/pkg"
+	"backend/pkg/logger"
+)
+
+const defaultVerificationResendInterval = time.Minute
+
+var errInvalidVerificationPolicy = errors.New("invalid UNVERIFIED_EMAIL_POLICY")
+
+type Config struct {
+	// Driver は送り方。誤ってメールを送らずに運用しないよう、既定値は持たない
+	Driver       string
+	From         string
+	LogFile      string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	// AppURL はメールに載せるリンクの先のフロントエンドの URL
+	AppURL string
+	// Production は本番環境かどうか。本番では log ドライバーを使えない
+	Production bool
+}
+
+func NewConfigMail() *Config {
+	return &Config{
+		Driver:       pkg.GetEnvDefault("MAIL_DRIVER", ""),
+		From:         pkg.GetEnvDefault("MAIL_FROM", "no-reply@localhost"),
+		LogFile:      pkg.GetEnvDefault("MAIL_LOG_FILE", ""),
+		SMTPHost:     pkg.GetEnvDefault("SMTP_HOST", ""),
+		SMTPPort:     pkg.GetEnvDefault("SMTP_PORT", "587"),
+		SMTPUsername: pkg.GetEnvDefault("SMTP_USERNAME", ""),
+		SMTPPassword: pkg.GetEnvDefault("SMTP_PASSWORD", ""),
+		AppURL:       pkg.GetEnvDefault("APP_URL", "http://localhost:3000"),
+		Production:   pkg.GetEnvDefault("APP_ENV", "development") == "production",
+	}
+}
+
+// NewEmailVerificationPolicy は未確認のユーザーへの制限（allow / read_only / block）と確認メールの再送間隔を環境変数から読み込む。
+// 制限の値が不正なときに制限なしで起動しないよう、既定値には戻さずエラーにする
+func NewEmailVerificationPolicy() (*entity.EmailVerificationPolicy, error) {
+	policy := &entity.EmailVerificationPolicy{
+		Restriction:    entity.UnverifiedAllow,
+		ResendInterval: defaultVerificationResendInterval,
+	}
+
+	restriction := pkg.GetEnvDefault("UNVERIFIED_EMAIL_POLICY", string(entity.UnverifiedAllow))
+	if err := policy.Restriction.Set(restriction); err != nil {
+		return nil, errInvalidVerificationPolicy
+	}
+
+	resendInterval := pkg.GetEnvDefault("EMAIL_VERIFICATION_RESEND_INTERVAL", defaultVerificationResendInterval.String())
+	if interval, err := time.ParseDuration(resendInterval); err == nil && interval >= 0 {
+		policy.ResendInterval = interval
+	} else {
+		logger.Warn("Invalid EMAIL_VERIFICATION_RESEND_INTERVAL, using default", "value", resendInterval, "default", policy.ResendInterval.String())
+	}
+	return policy, nil
+}
@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"errors"
+
+	"backend/adapter/gateway"
+)
+
+const (
+	DriverLog  = "log"
+	DriverSMTP = "smtp"
+)
+
+var (
+	errMissingMailDriver     = errors.New("MAIL_DRIVER is required")
+	errInvalidMailDriver     = errors.New("invalid mail driver")
+	errLogDriverInProduction = errors.New("the log mail driver cannot be used in production")
+	errMissingSMTPHost       = errors.New("SMTP_HOST is required for the smtp mail driver")
+)
+
+func NewMailerFactory(config *Config) (gateway.IMailer, error) {
+	switch config.Driver {
+	case "":
+		return nil, errMissingMailDriver
+
+	case DriverLog:
+		// メールを送らずに記録するだけなので、本番で使うとパスワードの再設定などが届かない
+		if config.Production {
+			return nil, errLogDriverInProduction
+		}
+		return gateway.NewFileMailer(config.LogFile, config.From), nil
+
+	case DriverSMTP:
+		if config.SMTPHost == "" {
+			return nil, errMissingSMTPHost
+		}
+		return gateway.NewSMTPMailer(config.SMTPHost, config.SMTPPort, config.SMTPUsername, config.SMTPPassword, config.From), nil
+	default:
+		return nil, errInvalidMailDriver
+	}
+}
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"strconv"
+	"strings"
+
+	"backend/entity"
+	"backend/pkg"
+	"backend/pkg/logger"
+)
+
+const (
+	defaultAttachmentMaxSizeMB = 10
+	defaultAttachmentTypes     = "image/png,image/jpeg,image/gif,image/webp,application/pdf"
+)
+
+type Config struct {
+	Driver         string
+	LocalDir       string
+	S3Bucket       string
+	S3Region       string
+	S3Endpoint     string
+	S3UsePathStyle bool
+}
+
+func NewConfigStorage() *Config {
+	return &Config{
+		Driver:         pkg.GetEnvDefault("STORAGE_DRIVER", "local"),
+		LocalDir:       pkg.GetEnvDefault("STORAGE_LOCAL_DIR", "storage"),
+		S3Bucket:       pkg.GetEnvDefault("STORAGE_S3_BUCKET", ""),
+		S3Region:       pkg.GetEnvDefault("STORAGE_S3_REGION", "ap-northeast-1"),
+		S3Endpoint:     pkg.GetEnvDefault("STORAGE_S3_ENDPOINT", ""),
+		S3UsePathStyle: pkg.GetEnvDefault("STORAGE_S3_USE_PATH_STYLE", "false") == "true",
+	}
+}
+
+// NewAttachmentPolicy は添付ファイルのサイズ（MB）と種類（MIME タイプのカンマ区切り）の制限を環境変数から読み込む
+func NewAttachmentPolicy() *entity.AttachmentPolicy {
+	policy := &entity.AttachmentPolicy{MaxSize: defaultAttachmentMaxSizeMB << 20}
+
+	maxSizeMB := pkg.GetEnvDefault("ATTACHMENT_MAX_SIZE_MB", strconv.Itoa(defaultAttachmentMaxSizeMB))
+	if size, err := strconv.Atoi(maxSizeMB); err == nil && size > 0 {
+		policy.MaxSize = int64(size) << 20
+	} else {
+		logger.Warn("Invalid ATTACHMENT_MAX_SIZE_MB, using default: " + maxSizeMB)
+	}
+
+	for _, contentType := range strings.Split(pkg.GetEnvDefault("ATTACHMENT_ALLOWED_TYPES", defaultAttachmentTypes), ",") {
+		if contentType = strings.TrimSpace(contentType); contentType != "" {
+			policy.AllowedTypes = append(policy.AllowedTypes, contentType)
+		}
+	}
+	return policy
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"errors"
+
+	"backend/adapter/gateway"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var (
+	errInvalidStorageDriver = errors.New("invalid storage driver")
+	errMissingS3Bucket      = errors.New("STORAGE_S3_BUCKET is required for the s3 storage driver")
+)
+
+func NewStorageFactory(config *Config) (gateway.IBlobStorage, error) {
+	switch config.Driver {
+	case DriverLocal:
+		return gateway.NewLocalStorage(config.LocalDir)
+
+	case DriverS3:
+		if config.S3Bucket == "" {
+			return nil, errMissingS3Bucket
+		}
+		// 認証情報は環境変数や EC2 のインスタンスプロファイルなど SDK の標準の方法で読み込む
+		awsCfg, err := awsConfig.LoadDefaultConfig(context.Background(), awsConfig.WithRegion(config.S3Region))
+		if err != nil {
+			return nil, err
+		}
+		client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+			if config.S3Endpoint != "" {
+				o.BaseEndpoint = aws.String(config.S3Endpoint)
+			}
+			o.UsePathStyle = config.S3UsePathStyle
+		})
+		return gateway.NewS3Storage(client, config.S3Bucket), nil
+	default:
+		return nil, errInvalidStorageDriver
+	}
+}
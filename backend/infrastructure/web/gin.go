@@ -8,6 +8,8 @@ import (
 	"gorm.io/gorm"
 
 	"backend/adapter/controller/router"
+	"backend/adapter/gateway"
+	"backend/entity"
 	"backend/pkg/logger"
 )
 
@@ -29,8 +31,8 @@ func (g *GinWebServer) Shutdown(ctx context.Context) error {
 	return g.server.Shutdown(ctx)
 }
 
-func NewGinServer(host, port string, corsAllowOrigins []string, db *gorm.DB) (IServer, error) {
-	router, err := router.NewGinRouter(db, corsAllowOrigins)
+func NewGinServer(host, port string, corsAllowOrigins []string, db *gorm.DB, blobStorage gateway.IBlobStorage, attachmentPolicy *entity.AttachmentPolicy, mailer gateway.IMailer, appURL string, verificationPolicy *entity.EmailVerificationPolicy) (IServer, error) {
+	router, err := router.NewGinRouter(db, corsAllowOrigins, blobStorage, attachmentPolicy, mailer, appURL, verificationPolicy)
 	if err != nil {
 		logger.Error(err.Error(), "host", host, "port", port)
 		return nil, err
@@ -0,0 +1,20 @@
+package markdown_test
+
+import (
+	"backend/pkg/markdown"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSafeHTML(t *testing.T) {
+	html, err := markdown.ToSafeHTML("# Title\n\n- [x] done\n\n**bold**")
+	assert.Nil(t, err)
+	assert.Contains(t, html, "<h1>Title</h1>")
+	assert.Contains(t, html, "<strong>bold</strong>")
+
+	html, err = markdown.ToSafeHTML("<script>alert(1)</script>[link](javascript:alert(1))")
+	assert.Nil(t, err)
+	assert.NotContains(t, html, "<script>")
+	assert.NotContains(t, html, "javascript:")
+}
@@ -1,7 +1,6 @@
 package pkg
 
 import (
-	"fmt"
 	"net"
 	"net/url"
 	"os"
@@ -32,7 +31,7 @@ func WaitForPort(host, port string, timeout time.Duration) bool {
 }
 
 func CheckPort(host, port string) bool {
-	conn, err := net.Dial("tcp", fmt.Sprintf("%s:%s", host, port))
+	conn, err := net.Dial("tcp", net.JoinHostPort(host, port))
 	if conn != nil {
 		conn.Close()
 		return false
@@ -1,11 +1,11 @@
 package tester
 
 import (
-	"backend/entity"
 	"backend/infrastructure/database"
 	"backend/pkg"
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/stretchr/testify/suite"
@@ -47,16 +47,18 @@ func (suite *DBPostgresSuite) SetupTestContainers() (err error) {
 }
 
 func (suite *DBPostgresSuite) SetupSuite() {
+	// コンテナを起動するため Docker が必要。TEST_POSTGRES を設定したときだけ実行する
+	if os.Getenv("TEST_POSTGRES") == "" {
+		suite.T().Skip("TEST_POSTGRES is not set")
+	}
+
 	err := suite.SetupTestContainers()
 	suite.Assert().Nil(err)
 
 	db, err := database.NewDatabaseSQLFactory(database.InstancePostgres)
 	suite.Assert().Nil(err)
 	suite.DB = db
-	for _, model := range entity.NewDomains() {
-		err = suite.DB.AutoMigrate(model)
-		suite.Assert().Nil(err)
-	}
+	suite.Assert().Nil(database.Migrate(suite.DB))
 }
 
 func (suite *DBPostgresSuite) TearDownSuite() {
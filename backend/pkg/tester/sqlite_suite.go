@@ -1,7 +1,6 @@
 package tester
 
 import (
-	"backend/entity"
 	"backend/infrastructure/database"
 	"fmt"
 	"os"
@@ -24,10 +23,7 @@ func (suite *DBSQLiteSuite) SetupSuite() {
 	suite.Assert().Nil(err)
 	suite.DB = db
 
-	for _, model := range entity.NewDomains() {
-		err := suite.DB.AutoMigrate(model)
-		suite.Assert().Nil(err)
-	}
+	suite.Assert().Nil(database.Migrate(suite.DB))
 }
 
 func (suite *DBSQLiteSuite) TearDownSuite() {
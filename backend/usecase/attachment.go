@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// sniffLength は http.DetectContentType が参照する先頭のバイト数
+const sniffLength = 512
+
+type IAttachmentUsecase interface {
+	Upload(ctx context.Context, attachment *entity.Attachment, body io.ReadSeeker) (*entity.Attachment, error)
+	GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Attachment, error)
+	Download(ctx context.Context, attachmentID entity.AttachmentID, taskID entity.TaskID, userID entity.UserID) (*entity.Attachment, io.ReadCloser, error)
+	Delete(ctx context.Context, attachmentID entity.AttachmentID, taskID entity.TaskID, userID entity.UserID) error
+}
+
+type attachmentUsecase struct {
+	ar     gateway.IAttachmentRepository
+	bs     gateway.IBlobStorage
+	policy *entity.AttachmentPolicy
+}
+
+func NewAttachmentUsecase(ar gateway.IAttachmentRepository, bs gateway.IBlobStorage, policy *entity.AttachmentPolicy) IAttachmentUsecase {
+	return &attachmentUsecase{ar: ar, bs: bs, policy: policy}
+}
+
+// detectContentType はクライアントの申告ではなくファイルの先頭から種類を判定する
+func detectContentType(body io.ReadSeeker) (string, error) {
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(body, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "", err
+	}
+	return contentType, nil
+}
+
+// Upload はタスクの所有者を確認し、ファイルをストレージに保存してから添付ファイルを登録する。登録に失敗したら保存したファイルを消す
+func (au *attachmentUsecase) Upload(ctx context.Context, attachment *entity.Attachment, body io.ReadSeeker) (*entity.Attachment, error) {
+	if err := au.ar.CheckTask(attachment.TaskID, attachment.UserID); err != nil {
+		return nil, err
+	}
+	contentType, err := detectContentType(body)
+	if err != nil {
+		return nil, err
+	}
+	if err := au.policy.Check(contentType, attachment.Size); err != nil {
+		return nil, err
+	}
+
+	attachment.FileName = entity.SanitizeFileName(attachment.FileName)
+	attachment.ContentType = contentType
+	attachment.StorageKey = fmt.Sprintf("tasks/%d/%s", attachment.TaskID, uuid.NewString())
+	if err := au.bs.Put(ctx, attachment.StorageKey, body, attachment.Size, contentType); err != nil {
+		return nil, err
+	}
+
+	createdAttachment, err := au.ar.Create(attachment)
+	if err != nil {
+		if deleteErr := au.bs.Delete(ctx, attachment.StorageKey); deleteErr != nil {
+			return nil, fmt.Errorf("%w (failed to delete the uploaded file: %v)", err, deleteErr)
+		}
+		return nil, err
+	}
+	return createdAttachment, nil
+}
+
+func (au *attachmentUsecase) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Attachment, error) {
+	return au.ar.GetAll(taskID, userID)
+}
+
+// Download は添付ファイルと中身を返す。中身は呼び出し側で閉じる
+func (au *attachmentUsecase) Download(ctx context.Context, attachmentID entity.AttachmentID, taskID entity.TaskID, userID entity.UserID) (*entity.Attachment, io.ReadCloser, error) {
+	attachment, err := au.ar.Get(attachmentID, taskID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := au.bs.Open(ctx, attachment.StorageKey)
+	if errors.Is(err, gateway.ErrBlobNotFound) {
+		return nil, nil, fmt.Errorf("%w: %w", entity.ErrAttachmentNotFound, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, body, nil
+}
+
+// Delete はストレージの中身を消してから添付ファイルを削除する。中身の削除に失敗しても再度削除できるよう行は残す
+func (au *attachmentUsecase) Delete(ctx context.Context, attachmentID entity.AttachmentID, taskID entity.TaskID, userID entity.UserID) error {
+	attachment, err := au.ar.Get(attachmentID, taskID, userID)
+	if err != nil {
+		return err
+	}
+	if err := au.bs.Delete(ctx, attachment.StorageKey); err != nil {
+		return err
+	}
+	return au.ar.Delete(attachment.ID)
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentRepository) CheckTask(taskID entity.TaskID, userID entity.UserID) error {
+	args := m.Called(taskID, userID)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) Create(attachment *entity.Attachment) (*entity.Attachment, error) {
+	args := m.Called(attachment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) Get(attachmentID entity.AttachmentID, taskID entity.TaskID, userID entity.UserID) (*entity.Attachment, error) {
+	args := m.Called(attachmentID, taskID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Attachment, error) {
+	args := m.Called(taskID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entity.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) Delete(attachmentID entity.AttachmentID) error {
+	args := m.Called(attachmentID)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) GetOrphans() (*[]entity.Attachment, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entity.Attachment), args.Error(1)
+}
+
+type MockBlobStorage struct {
+	mock.Mock
+}
+
+func (m *MockBlobStorage) Put(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error {
+	args := m.Called(key, size, contentType)
+	return args.Error(0)
+}
+
+func (m *MockBlobStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockBlobStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(key)
+	return args.Error(0)
+}
+
+// pngHeader は PNG として判定されるファイルの先頭
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+type AttachmentUsecaseSuite struct {
+	suite.Suite
+	ar *MockAttachmentRepository
+	bs *MockBlobStorage
+	au IAttachmentUsecase
+}
+
+func TestAttachmentUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentUsecaseSuite))
+}
+
+func (suite *AttachmentUsecaseSuite) SetupTest() {
+	suite.ar = new(MockAttachmentRepository)
+	suite.bs = new(MockBlobStorage)
+	policy := &entity.AttachmentPolicy{MaxSize: 1024, AllowedTypes: []string{"image/png", "application/pdf"}}
+	suite.au = NewAttachmentUsecase(suite.ar, suite.bs, policy)
+}
+
+func (suite *AttachmentUsecaseSuite) TestUpload() {
+	suite.ar.On("CheckTask", entity.TaskID(3), entity.UserID(1)).Return(nil)
+	suite.bs.On("Put", mock.Anything, int64(len(pngHeader)), "image/png").Return(nil)
+	suite.ar.On("Create", mock.Anything).Return(&entity.Attachment{ID: 1}, nil)
+
+	_, err := suite.au.Upload(context.Background(), &entity.Attachment{TaskID: 3, UserID: 1, FileName: "../shot.png", Size: int64(len(pngHeader))}, bytes.NewReader(pngHeader))
+	suite.Assert().Nil(err)
+
+	created := suite.ar.Calls[1].Arguments.Get(0).(*entity.Attachment)
+	suite.Assert().Equal("shot.png", created.FileName)
+	suite.Assert().Equal("image/png", created.ContentType)
+	suite.Assert().Regexp(`^tasks/3/[0-9a-f-]{36}$`, created.StorageKey)
+	suite.bs.AssertCalled(suite.T(), "Put", created.StorageKey, int64(len(pngHeader)), "image/png")
+}
+
+func (suite *AttachmentUsecaseSuite) TestUploadRejectsByContent() {
+	suite.ar.On("CheckTask", entity.TaskID(3), entity.UserID(1)).Return(nil)
+
+	// the declared file name does not matter, the content is HTML
+	_, err := suite.au.Upload(context.Background(), &entity.Attachment{TaskID: 3, UserID: 1, FileName: "shot.png", Size: 20}, bytes.NewReader([]byte("<html><body></body>")))
+	suite.Assert().ErrorIs(err, entity.ErrAttachmentTypeNotAllowed)
+
+	_, err = suite.au.Upload(context.Background(), &entity.Attachment{TaskID: 3, UserID: 1, FileName: "shot.png", Size: 2048}, bytes.NewReader(pngHeader))
+	suite.Assert().ErrorIs(err, entity.ErrAttachmentTooLarge)
+	suite.bs.AssertNotCalled(suite.T(), "Put", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AttachmentUsecaseSuite) TestUploadRejectsOtherUsersTask() {
+	suite.ar.On("CheckTask", entity.TaskID(3), entity.UserID(2)).Return(entity.ErrTaskNotFound)
+
+	// test nothing is written to the storage for a task the user does not own
+	_, err := suite.au.Upload(context.Background(), &entity.Attachment{TaskID: 3, UserID: 2, FileName: "shot.png", Size: int64(len(pngHeader))}, bytes.NewReader(pngHeader))
+	suite.Assert().ErrorIs(err, entity.ErrTaskNotFound)
+	suite.bs.AssertNotCalled(suite.T(), "Put", mock.Anything, mock.Anything, mock.Anything)
+	suite.ar.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *AttachmentUsecaseSuite) TestUploadRemovesFileWhenCreateFails() {
+	suite.ar.On("CheckTask", entity.TaskID(3), entity.UserID(2)).Return(nil)
+	suite.bs.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	suite.bs.On("Delete", mock.Anything).Return(nil)
+	suite.ar.On("Create", mock.Anything).Return(nil, errors.New("create error"))
+
+	_, err := suite.au.Upload(context.Background(), &entity.Attachment{TaskID: 3, UserID: 2, FileName: "shot.png", Size: int64(len(pngHeader))}, bytes.NewReader(pngHeader))
+	suite.Assert().NotNil(err)
+	suite.bs.AssertNumberOfCalls(suite.T(), "Delete", 1)
+}
+
+func (suite *AttachmentUsecaseSuite) TestDownloadMissingContent() {
+	suite.ar.On("Get", entity.AttachmentID(1), entity.TaskID(3), entity.UserID(1)).Return(&entity.Attachment{ID: 1, StorageKey: "tasks/3/a"}, nil)
+	suite.bs.On("Open", "tasks/3/a").Return(nil, gateway.ErrBlobNotFound)
+
+	_, _, err := suite.au.Download(context.Background(), 1, 3, 1)
+	suite.Assert().ErrorIs(err, entity.ErrAttachmentNotFound)
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+)
+
+type IChecklistUsecase interface {
+	Create(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error)
+	Save(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error)
+	Check(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) (*entity.ChecklistItem, error)
+	Uncheck(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) (*entity.ChecklistItem, error)
+	Reorder(taskID entity.TaskID, userID entity.UserID, itemIDs []entity.ChecklistItemID) (*[]entity.ChecklistItem, error)
+	Delete(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) error
+}
+
+type checklistUsecase struct {
+	cr gateway.IChecklistItemRepository
+}
+
+func NewChecklistUsecase(cr gateway.IChecklistItemRepository) IChecklistUsecase {
+	return &checklistUsecase{cr: cr}
+}
+
+func (cu *checklistUsecase) Create(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error) {
+	return cu.cr.Create(item, userID)
+}
+
+func (cu *checklistUsecase) Save(item *entity.ChecklistItem, userID entity.UserID) (*entity.ChecklistItem, error) {
+	return cu.cr.Save(item, userID)
+}
+
+func (cu *checklistUsecase) Check(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) (*entity.ChecklistItem, error) {
+	return cu.cr.SetChecked(itemID, taskID, userID, true)
+}
+
+func (cu *checklistUsecase) Uncheck(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) (*entity.ChecklistItem, error) {
+	return cu.cr.SetChecked(itemID, taskID, userID, false)
+}
+
+func (cu *checklistUsecase) Reorder(taskID entity.TaskID, userID entity.UserID, itemIDs []entity.ChecklistItemID) (*[]entity.ChecklistItem, error) {
+	return cu.cr.Reorder(taskID, userID, itemIDs)
+}
+
+func (cu *checklistUsecase) Delete(itemID entity.ChecklistItemID, taskID entity.TaskID, userID entity.UserID) error {
+	return cu.cr.Delete(itemID, taskID, userID)
+}
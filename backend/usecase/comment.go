@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+)
+
+type ICommentUsecase interface {
+	Create(comment *entity.Comment) (*entity.Comment, error)
+	GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Comment, error)
+	Save(comment *entity.Comment) (*entity.Comment, error)
+	Delete(commentID entity.CommentID, taskID entity.TaskID, userID entity.UserID) error
+}
+
+type commentUsecase struct {
+	cr gateway.ICommentRepository
+}
+
+func NewCommentUsecase(cr gateway.ICommentRepository) ICommentUsecase {
+	return &commentUsecase{cr: cr}
+}
+
+func (cu *commentUsecase) Create(comment *entity.Comment) (*entity.Comment, error) {
+	return cu.cr.Create(comment)
+}
+
+func (cu *commentUsecase) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.Comment, error) {
+	return cu.cr.GetAll(taskID, userID)
+}
+
+func (cu *commentUsecase) Save(comment *entity.Comment) (*entity.Comment, error) {
+	return cu.cr.Save(comment)
+}
+
+func (cu *commentUsecase) Delete(commentID entity.CommentID, taskID entity.TaskID, userID entity.UserID) error {
+	return cu.cr.Delete(commentID, taskID, userID)
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+)
+
+type IDependencyUsecase interface {
+	Create(dependency *entity.TaskDependency, userID entity.UserID) (*entity.Task, error)
+	Delete(taskID entity.TaskID, blockerID entity.TaskID, userID entity.UserID) error
+}
+
+type dependencyUsecase struct {
+	dr gateway.ITaskDependencyRepository
+	tr gateway.ITaskRepository
+}
+
+func NewDependencyUsecase(dr gateway.ITaskDependencyRepository, tr gateway.ITaskRepository) IDependencyUsecase {
+	return &dependencyUsecase{dr: dr, tr: tr}
+}
+
+// Create は依存関係を追加し、更新後のタスクを返す。追加によって循環が生じる場合はエラー
+func (du *dependencyUsecase) Create(dependency *entity.TaskDependency, userID entity.UserID) (*entity.Task, error) {
+	if _, err := du.dr.Create(dependency, userID); err != nil {
+		return nil, err
+	}
+	return du.tr.Get(dependency.TaskID, userID)
+}
+
+func (du *dependencyUsecase) Delete(taskID entity.TaskID, blockerID entity.TaskID, userID entity.UserID) error {
+	return du.dr.Delete(taskID, blockerID, userID)
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"backend/entity"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockTaskDependencyRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaskDependencyRepository) Create(dependency *entity.TaskDependency, userID entity.UserID) (*entity.TaskDependency, error) {
+	args := m.Called(dependency, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TaskDependency), args.Error(1)
+}
+
+func (m *MockTaskDependencyRepository) Delete(taskID entity.TaskID, blockerID entity.TaskID, userID entity.UserID) error {
+	args := m.Called(taskID, blockerID, userID)
+	return args.Error(0)
+}
+
+type DependencyUsecaseSuite struct {
+	suite.Suite
+	dr *MockTaskDependencyRepository
+	tr *MockTaskRepository
+	du IDependencyUsecase
+}
+
+func TestDependencyUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(DependencyUsecaseSuite))
+}
+
+func (suite *DependencyUsecaseSuite) SetupTest() {
+	suite.dr = new(MockTaskDependencyRepository)
+	suite.tr = new(MockTaskRepository)
+	suite.du = NewDependencyUsecase(suite.dr, suite.tr)
+}
+
+func (suite *DependencyUsecaseSuite) TestCreate() {
+	dependency := &entity.TaskDependency{TaskID: 3, BlockerID: 2}
+	task := &entity.Task{ID: 3, Blockers: []entity.TaskDependency{*dependency}}
+	suite.dr.On("Create", dependency, entity.UserID(1)).Return(dependency, nil)
+	suite.tr.On("Get", entity.TaskID(3), entity.UserID(1)).Return(task, nil)
+
+	createdTask, err := suite.du.Create(dependency, 1)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(task, createdTask)
+}
+
+func (suite *DependencyUsecaseSuite) TestCreateRejectsCycle() {
+	suite.dr.On("Create", mock.Anything, entity.UserID(1)).Return(nil, entity.ErrDependencyCycle)
+
+	_, err := suite.du.Create(&entity.TaskDependency{TaskID: 1, BlockerID: 2}, 1)
+	suite.Assert().ErrorIs(err, entity.ErrDependencyCycle)
+	suite.tr.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+}
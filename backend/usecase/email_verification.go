@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/logger"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+)
+
+const verificationMailTimeout = 10 * time.Second
+
+type IEmailVerificationUsecase interface {
+	Verify(token string) error
+	Resend(userID entity.UserID) error
+}
+
+type emailVerificationUsecase struct {
+	ur     gateway.IUserRepository
+	mailer gateway.IMailer
+	appURL string
+	policy *entity.EmailVerificationPolicy
+}
+
+func NewEmailVerificationUsecase(ur gateway.IUserRepository, mailer gateway.IMailer, appURL string, policy *entity.EmailVerificationPolicy) IEmailVerificationUsecase {
+	return &emailVerificationUsecase{ur: ur, mailer: mailer, appURL: appURL, policy: policy}
+}
+
+// Verify は確認メールのリンクのトークンを確かめ、メールアドレスを確認済みにする。確認済みでもエラーにしない
+func (eu *emailVerificationUsecase) Verify(token string) error {
+	userID, err := entity.ParseEmailVerificationToken(token)
+	if err != nil {
+		return err
+	}
+	user, err := eu.ur.Get(userID)
+	if err != nil {
+		return entity.ErrInvalidEmailVerificationToken
+	}
+	now := time.Now()
+	if err := entity.VerifyEmailVerificationToken([]byte(os.Getenv("SECRET")), token, user, now); err != nil {
+		return err
+	}
+	return eu.ur.VerifyEmail(user.ID, now)
+}
+
+// Resend は確認メールを送り直す。前に送ってから policy の間隔が経っていなければ ErrEmailVerificationThrottled を返す
+func (eu *emailVerificationUsecase) Resend(userID entity.UserID) error {
+	user, err := eu.ur.Get(userID)
+	if err != nil {
+		return err
+	}
+	if user.IsEmailVerified() {
+		return entity.ErrEmailAlreadyVerified
+	}
+
+	now := time.Now()
+	if err := eu.ur.ClaimVerificationSend(user.ID, now.Add(-eu.policy.ResendInterval), now); err != nil {
+		return err
+	}
+	previous := user.VerificationSentAt
+	sendVerificationMail(eu.mailer, eu.appURL, user, now, func(err error) {
+		if err == nil {
+			return
+		}
+		// 送れなかったときはすぐに再送できるよう、取った権利を戻す
+		if err := eu.ur.SetVerificationSentAt(user.ID, previous); err != nil {
+			logger.Error(fmt.Sprintf("Failed to release verification send of user %d: %s", user.ID, err.Error()))
+		}
+	})
+	return nil
+}
+
+// sendVerificationMail は user のメールアドレスに確認用のリンクを非同期に送り、送り終えたら結果を done に渡す
+func sendVerificationMail(mailer gateway.IMailer, appURL string, user *entity.User, now time.Time, done func(err error)) {
+	token := entity.NewEmailVerificationToken([]byte(os.Getenv("SECRET")), user, now.Add(entity.EmailVerificationLifetime))
+	link := appURL + "/verify-email?token=" + url.QueryEscape(token)
+	mail := &entity.Mail{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Open the link below to verify your email address. The link expires in %d hours.\n\n%s\n\n"+
+			"If you did not create an account, you can ignore this mail.\n",
+			int(entity.EmailVerificationLifetime.Hours()), link),
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), verificationMailTimeout)
+		defer cancel()
+		err := mailer.Send(ctx, mail)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed to send verification mail to user %d: %s", user.ID, err.Error()))
+		}
+		done(err)
+	}()
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"backend/entity"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmailVerificationUsecaseSuite struct {
+	suite.Suite
+	ur     *MockUserRepository
+	mailer *MockMailer
+	eu     IEmailVerificationUsecase
+}
+
+func TestEmailVerificationUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(EmailVerificationUsecaseSuite))
+}
+
+func (suite *EmailVerificationUsecaseSuite) SetupTest() {
+	suite.T().Setenv("SECRET", "secret")
+	suite.ur = new(MockUserRepository)
+	suite.mailer = new(MockMailer)
+	policy := &entity.EmailVerificationPolicy{Restriction: entity.UnverifiedReadOnly, ResendInterval: time.Minute}
+	suite.eu = NewEmailVerificationUsecase(suite.ur, suite.mailer, "https://app.test", policy)
+}
+
+func (suite *EmailVerificationUsecaseSuite) TestVerify() {
+	user := &entity.User{ID: 1, Email: "user@test.com"}
+	suite.ur.On("Get", entity.UserID(1)).Return(user, nil)
+	suite.ur.On("VerifyEmail", entity.UserID(1), mock.Anything).Return(nil)
+
+	// test a token signed for the user verifies the email
+	token := entity.NewEmailVerificationToken([]byte("secret"), user, time.Now().Add(time.Hour))
+	suite.Assert().Nil(suite.eu.Verify(token))
+	suite.ur.AssertNumberOfCalls(suite.T(), "VerifyEmail", 1)
+
+	// test forged and expired tokens are rejected
+	forged := entity.NewEmailVerificationToken([]byte("other"), user, time.Now().Add(time.Hour))
+	suite.Assert().ErrorIs(suite.eu.Verify(forged), entity.ErrInvalidEmailVerificationToken)
+	expired := entity.NewEmailVerificationToken([]byte("secret"), user, time.Now().Add(-time.Minute))
+	suite.Assert().ErrorIs(suite.eu.Verify(expired), entity.ErrInvalidEmailVerificationToken)
+	suite.ur.AssertNumberOfCalls(suite.T(), "VerifyEmail", 1)
+}
+
+func (suite *EmailVerificationUsecaseSuite) TestResend() {
+	now := time.Now()
+	suite.ur.On("Get", entity.UserID(1)).Return(&entity.User{ID: 1, Email: "user@test.com"}, nil)
+	suite.ur.On("Get", entity.UserID(2)).Return(&entity.User{ID: 2, Email: "verified@test.com", EmailVerifiedAt: &now}, nil)
+	suite.ur.On("ClaimVerificationSend", entity.UserID(1), mock.MatchedBy(func(since time.Time) bool {
+		return since.Before(time.Now().Add(-59 * time.Second))
+	}), mock.Anything).Return(nil).Once()
+	mails := make(chan *entity.Mail, 1)
+	suite.mailer.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mails <- args.Get(1).(*entity.Mail)
+	}).Return(nil)
+
+	// test the mail is sent again after the interval
+	suite.Assert().Nil(suite.eu.Resend(1))
+	suite.Assert().Equal("user@test.com", receiveMail(suite.T(), mails).To)
+
+	// test resending within the interval is throttled
+	suite.ur.On("ClaimVerificationSend", entity.UserID(1), mock.Anything, mock.Anything).Return(entity.ErrEmailVerificationThrottled)
+	suite.Assert().ErrorIs(suite.eu.Resend(1), entity.ErrEmailVerificationThrottled)
+	suite.mailer.AssertNumberOfCalls(suite.T(), "Send", 1)
+
+	// test verified users need no mail
+	suite.Assert().ErrorIs(suite.eu.Resend(2), entity.ErrEmailAlreadyVerified)
+	suite.ur.AssertNotCalled(suite.T(), "SetVerificationSentAt", mock.Anything, mock.Anything)
+}
+
+func (suite *EmailVerificationUsecaseSuite) TestResendReleasesClaimWhenMailFails() {
+	sentAt := time.Now().Add(-time.Hour)
+	suite.ur.On("Get", entity.UserID(1)).Return(&entity.User{ID: 1, Email: "user@test.com", VerificationSentAt: &sentAt}, nil)
+	suite.ur.On("ClaimVerificationSend", entity.UserID(1), mock.Anything, mock.Anything).Return(nil)
+	suite.mailer.On("Send", mock.Anything, mock.Anything).Return(errors.New("smtp down"))
+	released := make(chan *time.Time, 1)
+	suite.ur.On("SetVerificationSentAt", entity.UserID(1), mock.Anything).Run(func(args mock.Arguments) {
+		released <- args.Get(1).(*time.Time)
+	}).Return(nil)
+
+	// test the previous send time is restored so the user can resend right away
+	suite.Assert().Nil(suite.eu.Resend(1))
+	select {
+	case previous := <-released:
+		suite.Assert().Equal(&sentAt, previous)
+	case <-time.After(time.Second):
+		suite.T().Fatal("claim was not released")
+	}
+}
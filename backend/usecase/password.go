@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"backend/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordResetMailTimeout = 10 * time.Second
+
+type IPasswordUsecase interface {
+	Forgot(email string) error
+	Reset(rawToken string, password string) error
+}
+
+type passwordUsecase struct {
+	ur     gateway.IUserRepository
+	pr     gateway.IPasswordResetRepository
+	sr     gateway.ISessionRepository
+	mailer gateway.IMailer
+	appURL string
+}
+
+// NewPasswordUsecase は appURL のフロントエンドの再設定ページへのリンクをメールで送るユースケースを返す
+func NewPasswordUsecase(ur gateway.IUserRepository, pr gateway.IPasswordResetRepository, sr gateway.ISessionRepository, mailer gateway.IMailer, appURL string) IPasswordUsecase {
+	return &passwordUsecase{ur: ur, pr: pr, sr: sr, mailer: mailer, appURL: appURL}
+}
+
+// Forgot はパスワードの再設定用のリンクをメールで送る。
+// メールアドレスが登録されているかを知られないよう、未登録やメールの送信の失敗はエラーにしない。
+// 応答時間の差からも知られないよう、メールはリクエストを待たせずに送る
+func (pu *passwordUsecase) Forgot(email string) error {
+	user, err := pu.ur.GetByEmail(email)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		logger.Info("Password reset requested for unknown email")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, raw, err := entity.NewPasswordResetToken(user.ID, time.Now())
+	if err != nil {
+		return err
+	}
+	if _, err := pu.pr.Create(token); err != nil {
+		return err
+	}
+
+	mail := pu.resetMail(user.Email, raw)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), passwordResetMailTimeout)
+		defer cancel()
+		if err := pu.mailer.Send(ctx, mail); err != nil {
+			logger.Error(fmt.Sprintf("Failed to send password reset mail to user %d: %s", user.ID, err.Error()))
+		}
+	}()
+	return nil
+}
+
+func (pu *passwordUsecase) resetMail(to string, rawToken string) *entity.Mail {
+	link := pu.appURL + "/password/reset?token=" + url.QueryEscape(rawToken)
+	return &entity.Mail{
+		To:      to,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Open the link below to choose a new password. The link expires in %d minutes and can be used once.\n\n%s\n\n"+
+			"If you did not ask to reset your password, you can ignore this mail.\n",
+			int(entity.PasswordResetTokenLifetime.Minutes()), link),
+	}
+}
+
+// Reset はトークンを使ってパスワードを変更し、盗まれた端末が使い続けられないようにすべてのセッションを失効させる
+func (pu *passwordUsecase) Reset(rawToken string, password string) error {
+	now := time.Now()
+	token, err := pu.pr.GetByHash(entity.HashToken(rawToken))
+	if err != nil {
+		return err
+	}
+	if !token.IsUsable(now) {
+		return entity.ErrInvalidPasswordResetToken
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), 10)
+	if err != nil {
+		logger.Error("Failed to hash password: " + err.Error())
+		return err
+	}
+	if err := pu.pr.Consume(token, string(hash), now); err != nil {
+		return err
+	}
+	return pu.sr.RevokeAll(token.UserID, now)
+}
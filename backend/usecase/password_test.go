@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"backend/entity"
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type MockPasswordResetRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordResetRepository) Create(token *entity.PasswordResetToken) (*entity.PasswordResetToken, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PasswordResetToken), args.Error(1)
+}
+
+func (m *MockPasswordResetRepository) GetByHash(tokenHash string) (*entity.PasswordResetToken, error) {
+	args := m.Called(tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PasswordResetToken), args.Error(1)
+}
+
+func (m *MockPasswordResetRepository) Consume(token *entity.PasswordResetToken, passwordHash string, now time.Time) error {
+	args := m.Called(token, passwordHash, now)
+	return args.Error(0)
+}
+
+type MockMailer struct {
+	mock.Mock
+}
+
+func (m *MockMailer) Send(ctx context.Context, mail *entity.Mail) error {
+	args := m.Called(ctx, mail)
+	return args.Error(0)
+}
+
+type PasswordUsecaseSuite struct {
+	suite.Suite
+	ur     *MockUserRepository
+	pr     *MockPasswordResetRepository
+	sr     *MockSessionRepository
+	mailer *MockMailer
+	pu     IPasswordUsecase
+}
+
+func TestPasswordUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(PasswordUsecaseSuite))
+}
+
+func (suite *PasswordUsecaseSuite) SetupTest() {
+	suite.ur = new(MockUserRepository)
+	suite.pr = new(MockPasswordResetRepository)
+	suite.sr = new(MockSessionRepository)
+	suite.mailer = new(MockMailer)
+	suite.pu = NewPasswordUsecase(suite.ur, suite.pr, suite.sr, suite.mailer, "https://app.test")
+}
+
+func (suite *PasswordUsecaseSuite) TestForgotSendsResetLink() {
+	suite.ur.On("GetByEmail", "user@test.com").Return(&entity.User{ID: 1, Email: "user@test.com"}, nil)
+	var stored *entity.PasswordResetToken
+	suite.pr.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(0).(*entity.PasswordResetToken)
+	}).Return(&entity.PasswordResetToken{}, nil)
+	mails := make(chan *entity.Mail, 1)
+	suite.mailer.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mails <- args.Get(1).(*entity.Mail)
+	}).Return(nil)
+
+	suite.Assert().Nil(suite.pu.Forgot("user@test.com"))
+	sent := receiveMail(suite.T(), mails)
+	suite.Assert().Equal("user@test.com", sent.To)
+
+	// test the link carries the token whose hash is stored
+	start := strings.Index(sent.Body, "https://app.test/password/reset?token=")
+	suite.Require().NotEqual(-1, start)
+	link, err := url.Parse(strings.Fields(sent.Body[start:])[0])
+	suite.Require().Nil(err)
+	suite.Assert().Equal(stored.TokenHash, entity.HashToken(link.Query().Get("token")))
+	suite.Assert().Equal(entity.UserID(1), stored.UserID)
+}
+
+func (suite *PasswordUsecaseSuite) TestForgotDoesNotRevealAccounts() {
+	// test unknown emails succeed without mail
+	suite.ur.On("GetByEmail", "unknown@test.com").Return(nil, entity.ErrUserNotFound)
+	suite.Assert().Nil(suite.pu.Forgot("unknown@test.com"))
+	suite.mailer.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything)
+
+	// test the request does not wait for the mail and its failures are not reported
+	suite.ur.On("GetByEmail", "user@test.com").Return(&entity.User{ID: 1, Email: "user@test.com"}, nil)
+	suite.pr.On("Create", mock.Anything).Return(&entity.PasswordResetToken{}, nil)
+	release := make(chan struct{})
+	mails := make(chan *entity.Mail, 1)
+	suite.mailer.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		<-release
+		mails <- args.Get(1).(*entity.Mail)
+	}).Return(errors.New("smtp error"))
+	suite.Assert().Nil(suite.pu.Forgot("user@test.com"))
+	close(release)
+	receiveMail(suite.T(), mails)
+}
+
+// receiveMail は非同期に送られたメールを待つ
+func receiveMail(t *testing.T, mails <-chan *entity.Mail) *entity.Mail {
+	select {
+	case mail := <-mails:
+		return mail
+	case <-time.After(time.Second):
+		t.Fatal("mail was not sent")
+		return nil
+	}
+}
+
+func (suite *PasswordUsecaseSuite) TestReset() {
+	token := &entity.PasswordResetToken{ID: 1, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}
+	suite.pr.On("GetByHash", entity.HashToken("raw")).Return(token, nil)
+	suite.pr.On("Consume", token, mock.MatchedBy(func(hash string) bool {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte("new password")) == nil
+	}), mock.Anything).Return(nil)
+	suite.sr.On("RevokeAll", entity.UserID(1), mock.Anything).Return(nil)
+
+	// test the password is changed and every session is ended
+	suite.Assert().Nil(suite.pu.Reset("raw", "new password"))
+	suite.pr.AssertNumberOfCalls(suite.T(), "Consume", 1)
+	suite.sr.AssertNumberOfCalls(suite.T(), "RevokeAll", 1)
+
+	// test expired tokens are rejected
+	expired := &entity.PasswordResetToken{ID: 2, UserID: 1, ExpiresAt: time.Now().Add(-time.Minute)}
+	suite.pr.On("GetByHash", entity.HashToken("expired")).Return(expired, nil)
+	suite.Assert().ErrorIs(suite.pu.Reset("expired", "new password"), entity.ErrInvalidPasswordResetToken)
+	suite.pr.AssertNumberOfCalls(suite.T(), "Consume", 1)
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+)
+
+type IProjectUsecase interface {
+	Create(project *entity.Project) (*entity.Project, error)
+	Get(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error)
+	GetAll(userID entity.UserID, includeArchived bool) (*[]entity.Project, error)
+	Save(project *entity.Project) (*entity.Project, error)
+	Archive(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error)
+	Unarchive(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error)
+	Delete(projectID entity.ProjectID, userID entity.UserID, mode entity.ProjectDeleteMode) error
+}
+
+type projectUsecase struct {
+	pr gateway.IProjectRepository
+}
+
+func NewProjectUsecase(pr gateway.IProjectRepository) IProjectUsecase {
+	return &projectUsecase{pr: pr}
+}
+
+func (pu *projectUsecase) Create(project *entity.Project) (*entity.Project, error) {
+	return pu.pr.Create(project)
+}
+
+func (pu *projectUsecase) Get(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error) {
+	return pu.pr.Get(projectID, userID)
+}
+
+func (pu *projectUsecase) GetAll(userID entity.UserID, includeArchived bool) (*[]entity.Project, error) {
+	return pu.pr.GetAll(userID, includeArchived)
+}
+
+func (pu *projectUsecase) Save(project *entity.Project) (*entity.Project, error) {
+	return pu.pr.Save(project)
+}
+
+func (pu *projectUsecase) Archive(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error) {
+	return pu.pr.SetArchived(projectID, userID, true)
+}
+
+func (pu *projectUsecase) Unarchive(projectID entity.ProjectID, userID entity.UserID) (*entity.Project, error) {
+	return pu.pr.SetArchived(projectID, userID, false)
+}
+
+func (pu *projectUsecase) Delete(projectID entity.ProjectID, userID entity.UserID, mode entity.ProjectDeleteMode) error {
+	return pu.pr.Delete(projectID, userID, mode)
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"time"
+)
+
+type IReportUsecase interface {
+	GetEstimateReport(userID entity.UserID, query *entity.EstimateReportQuery) (*[]entity.EstimateReportRow, error)
+}
+
+type reportUsecase struct {
+	rr gateway.IReportRepository
+}
+
+func NewReportUsecase(rr gateway.IReportRepository) IReportUsecase {
+	return &reportUsecase{rr: rr}
+}
+
+func (ru *reportUsecase) GetEstimateReport(userID entity.UserID, query *entity.EstimateReportQuery) (*[]entity.EstimateReportRow, error) {
+	if err := query.Normalize(time.Now()); err != nil {
+		return nil, err
+	}
+	return ru.rr.GetEstimateReport(userID, query)
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"time"
+)
+
+type ISessionUsecase interface {
+	GetAll(userID entity.UserID) (*[]entity.Session, error)
+	Revoke(sessionID entity.SessionID, userID entity.UserID) error
+}
+
+type sessionUsecase struct {
+	sr gateway.ISessionRepository
+}
+
+func NewSessionUsecase(sr gateway.ISessionRepository) ISessionUsecase {
+	return &sessionUsecase{sr: sr}
+}
+
+func (su *sessionUsecase) GetAll(userID entity.UserID) (*[]entity.Session, error) {
+	return su.sr.GetAll(userID, time.Now())
+}
+
+// Revoke はセッションを失効させ、その端末でのトークンの更新をできなくする
+func (su *sessionUsecase) Revoke(sessionID entity.SessionID, userID entity.UserID) error {
+	return su.sr.Revoke(sessionID, userID, time.Now())
+}
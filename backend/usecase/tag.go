@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+)
+
+type ITagUsecase interface {
+	Create(tag *entity.Tag) (*entity.Tag, error)
+	Get(tagID entity.TagID, userID entity.UserID) (*entity.Tag, error)
+	GetAll(userID entity.UserID) (*[]entity.Tag, error)
+	Save(tag *entity.Tag) (*entity.Tag, error)
+	Delete(tagID entity.TagID, userID entity.UserID) error
+}
+
+type tagUsecase struct {
+	tgr gateway.ITagRepository
+}
+
+func NewTagUsecase(tgr gateway.ITagRepository) ITagUsecase {
+	return &tagUsecase{tgr: tgr}
+}
+
+func (tgu *tagUsecase) Create(tag *entity.Tag) (*entity.Tag, error) {
+	return tgu.tgr.Create(tag)
+}
+
+func (tgu *tagUsecase) Get(tagID entity.TagID, userID entity.UserID) (*entity.Tag, error) {
+	return tgu.tgr.Get(tagID, userID)
+}
+
+func (tgu *tagUsecase) GetAll(userID entity.UserID) (*[]entity.Tag, error) {
+	return tgu.tgr.GetAll(userID)
+}
+
+func (tgu *tagUsecase) Save(tag *entity.Tag) (*entity.Tag, error) {
+	return tgu.tgr.Save(tag)
+}
+
+func (tgu *tagUsecase) Delete(tagID entity.TagID, userID entity.UserID) error {
+	return tgu.tgr.Delete(tagID, userID)
+}
@@ -3,40 +3,254 @@ package usecase
 import (
 	"backend/adapter/gateway"
 	"backend/entity"
+	"context"
+	"errors"
+	"time"
 )
 
 type ITaskUsecase interface {
 	Create(task *entity.Task) (*entity.Task, error)
 	Get(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error)
-	GetAll(userID entity.UserID) (*[]entity.Task, error)
-	Save(task *entity.Task) (*entity.Task, error)
+	GetAll(userID entity.UserID, query *entity.TaskQuery) (*entity.TaskPage, error)
+	Save(task *entity.Task, clear []entity.ClearField) (*entity.Task, error)
+	MoveToProject(taskID entity.TaskID, userID entity.UserID, projectID *entity.ProjectID) (*entity.Task, error)
+	Snooze(taskID entity.TaskID, userID entity.UserID, until *time.Time) (*entity.Task, error)
+	Move(taskID entity.TaskID, userID entity.UserID, status entity.StatusName, prevID *entity.TaskID, nextID *entity.TaskID) (*entity.Task, error)
 	Delete(taskID entity.TaskID, userID entity.UserID) error
+	GetHistory(taskID entity.TaskID, userID entity.UserID, query *entity.TaskEventQuery) (*entity.TaskEventPage, error)
+	GetTrash(userID entity.UserID) (*[]entity.Task, error)
+	Restore(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error)
+	DeletePermanently(taskID entity.TaskID, userID entity.UserID) error
+	PurgeTrash(retention time.Duration) (int64, error)
 }
 
 type taskUsecase struct {
-	tr gateway.ITaskRepository
+	tr  gateway.ITaskRepository
+	er  gateway.ITaskEventRepository
+	ar  gateway.IAttachmentRepository
+	bs  gateway.IBlobStorage
+	ter gateway.ITimeEntryRepository
 }
 
-func NewTaskUsecase(tr gateway.ITaskRepository) ITaskUsecase {
-	return &taskUsecase{tr: tr}
+func NewTaskUsecase(tr gateway.ITaskRepository, er gateway.ITaskEventRepository, ar gateway.IAttachmentRepository, bs gateway.IBlobStorage, ter gateway.ITimeEntryRepository) ITaskUsecase {
+	return &taskUsecase{tr: tr, er: er, ar: ar, bs: bs, ter: ter}
 }
 
+// タスクの履歴は ITaskRepository が変更と同じトランザクションで記録する
 func (tu *taskUsecase) Create(task *entity.Task) (*entity.Task, error) {
 	return tu.tr.Create(task)
 }
 
+func (tu *taskUsecase) GetHistory(taskID entity.TaskID, userID entity.UserID, query *entity.TaskEventQuery) (*entity.TaskEventPage, error) {
+	query.Normalize()
+	return tu.er.GetAll(taskID, userID, query)
+}
+
 func (tu *taskUsecase) Get(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error) {
 	return tu.tr.Get(taskID, userID)
 }
 
-func (tu *taskUsecase) GetAll(userID entity.UserID) (*[]entity.Task, error) {
-	return tu.tr.GetAll(userID)
+func (tu *taskUsecase) GetAll(userID entity.UserID, query *entity.TaskQuery) (*entity.TaskPage, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	return tu.tr.GetAll(userID, query)
 }
 
-func (tu *taskUsecase) Save(task *entity.Task) (*entity.Task, error) {
-	return tu.tr.Save(task)
+// Save はタスクを更新する。clear の項目は値を消す
+func (tu *taskUsecase) Save(task *entity.Task, clear []entity.ClearField) (*entity.Task, error) {
+	currentTask, err := tu.tr.Get(task.ID, task.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTransition(currentTask, task.Status.Name); err != nil {
+		return nil, err
+	}
+
+	savedTask, err := tu.tr.Save(task, clear)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tu.afterTransition(currentTask, savedTask); err != nil {
+		return nil, err
+	}
+	return savedTask, nil
+}
+
+// Move はかんばん上でタスクを status の列の prevID と nextID の間に移動する
+func (tu *taskUsecase) Move(taskID entity.TaskID, userID entity.UserID, status entity.StatusName, prevID *entity.TaskID, nextID *entity.TaskID) (*entity.Task, error) {
+	currentTask, err := tu.tr.Get(taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTransition(currentTask, status); err != nil {
+		return nil, err
+	}
+
+	movedTask, err := tu.tr.Move(taskID, userID, status, prevID, nextID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tu.afterTransition(currentTask, movedTask); err != nil {
+		return nil, err
+	}
+	return movedTask, nil
+}
+
+// checkTransition はステータスを status に変更できるかを確認する。
+// 未完了のタスクにブロックされている間は着手・完了にできない
+func checkTransition(currentTask *entity.Task, status entity.StatusName) error {
+	if currentTask.Status.Name != status && status.RequiresUnblocked() && currentTask.IsBlocked() {
+		return entity.ErrTaskBlocked
+	}
+	return nil
+}
+
+// afterTransition はステータスの変更に伴う処理を行う。
+// 完了したタスクのタイマーを止め、繰り返しタスクは次のタスクを作成する
+func (tu *taskUsecase) afterTransition(currentTask *entity.Task, savedTask *entity.Task) error {
+	if currentTask.Status.Name != entity.Done && savedTask.Status.Name == entity.Done {
+		if err := tu.stopTaskTimer(savedTask); err != nil {
+			return err
+		}
+		if _, err := tu.createNextOccurrence(savedTask); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stopTaskTimer は task のタイマーが計測中なら止める
+func (tu *taskUsecase) stopTaskTimer(task *entity.Task) error {
+	entry, err := tu.ter.GetRunning(task.UserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrTimerNotRunning) {
+			return nil
+		}
+		return err
+	}
+	if entry.TaskID != task.ID {
+		return nil
+	}
+	_, err = stopTimer(tu.ter, entry)
+	return err
+}
+
+// createNextOccurrence は繰り返しタスクが完了したときに、期限を進めた次のタスクを作成する。
+// 繰り返しが設定されていない、または繰り返しが終了している場合は nil を返す
+func (tu *taskUsecase) createNextOccurrence(task *entity.Task) (*entity.Task, error) {
+	if task.Recurrence == nil {
+		return nil, nil
+	}
+
+	from := entity.DateOf(time.Now())
+	if task.Deadline != nil {
+		from = entity.DateOf(*task.Deadline)
+	}
+	deadline, rule, ok := task.Recurrence.Next(from)
+	if !ok {
+		return nil, nil
+	}
+
+	checklistItems := make([]entity.ChecklistItem, len(task.ChecklistItems))
+	for i, item := range task.ChecklistItems {
+		checklistItems[i] = entity.ChecklistItem{Text: item.Text, Position: item.Position}
+	}
+	tags := make([]entity.Tag, len(task.Tags))
+	for i, tag := range task.Tags {
+		tags[i] = entity.Tag{ID: tag.ID}
+	}
+
+	nextTask := &entity.Task{
+		Name:           task.Name,
+		Description:    task.Description,
+		Priority:       task.Priority,
+		Status:         entity.Status{Name: entity.Todo},
+		UserID:         task.UserID,
+		ProjectID:      task.ProjectID,
+		Tags:           tags,
+		ChecklistItems: checklistItems,
+		Recurrence:     rule,
+	}
+	if task.Estimate != nil {
+		estimate := *task.Estimate
+		nextTask.Estimate = &estimate
+	}
+	// 着手日時は期限と同じだけ進める
+	if task.StartAt != nil {
+		startAt := task.StartAt.Add(deadline.Sub(from))
+		nextTask.StartAt = &startAt
+	}
+	// 時刻付きの期限は、期限のタイムゾーンで同じ時刻のまま日付を進める
+	if err := nextTask.SetDeadline(&deadline, task.DueTime(), task.TimeZone); err != nil {
+		return nil, err
+	}
+	return tu.Create(nextTask)
+}
+
+func (tu *taskUsecase) MoveToProject(taskID entity.TaskID, userID entity.UserID, projectID *entity.ProjectID) (*entity.Task, error) {
+	return tu.tr.MoveToProject(taskID, userID, projectID)
+}
+
+func (tu *taskUsecase) Snooze(taskID entity.TaskID, userID entity.UserID, until *time.Time) (*entity.Task, error) {
+	currentTask, err := tu.tr.Get(taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	snoozedTask := *currentTask
+	if err := snoozedTask.Snooze(until, time.Now()); err != nil {
+		return nil, err
+	}
+	return tu.tr.Snooze(taskID, userID, snoozedTask.SnoozedUntil)
 }
 
 func (tu *taskUsecase) Delete(taskID entity.TaskID, userID entity.UserID) error {
 	return tu.tr.Delete(taskID, userID)
 }
+
+func (tu *taskUsecase) GetTrash(userID entity.UserID) (*[]entity.Task, error) {
+	return tu.tr.GetTrash(userID)
+}
+
+func (tu *taskUsecase) Restore(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error) {
+	return tu.tr.Restore(taskID, userID)
+}
+
+func (tu *taskUsecase) DeletePermanently(taskID entity.TaskID, userID entity.UserID) error {
+	if err := tu.tr.DeletePermanently(taskID, userID); err != nil {
+		return err
+	}
+	return tu.cleanupAttachments()
+}
+
+// PurgeTrash は retention より長くゴミ箱にあるタスクを完全に削除し、削除した件数を返す
+func (tu *taskUsecase) PurgeTrash(retention time.Duration) (int64, error) {
+	purged, err := tu.tr.Purge(time.Now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+	if err := tu.cleanupAttachments(); err != nil {
+		return purged, err
+	}
+	return purged, nil
+}
+
+// cleanupAttachments は完全に削除されたタスクの添付ファイルをストレージから削除する。
+// 削除に失敗した添付ファイルは残り、次に完全削除やゴミ箱の掃除をしたときに再び削除する
+func (tu *taskUsecase) cleanupAttachments() error {
+	attachments, err := tu.ar.GetOrphans()
+	if err != nil {
+		return err
+	}
+	for _, attachment := range *attachments {
+		if err := tu.bs.Delete(context.Background(), attachment.StorageKey); err != nil {
+			return err
+		}
+		if err := tu.ar.Delete(attachment.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,383 @@
+package usecase
+
+import (
+	"backend/entity"
+	"backend/pkg"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockTaskRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaskRepository) Create(task *entity.Task) (*entity.Task, error) {
+	args := m.Called(task)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Get(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error) {
+	args := m.Called(taskID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) GetAll(userID entity.UserID, query *entity.TaskQuery) (*entity.TaskPage, error) {
+	args := m.Called(userID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TaskPage), args.Error(1)
+}
+
+func (m *MockTaskRepository) GetSmartList(userID entity.UserID, query *entity.SmartListQuery) (*[]entity.Task, error) {
+	args := m.Called(userID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Save(task *entity.Task, clear []entity.ClearField) (*entity.Task, error) {
+	args := m.Called(task, clear)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) MoveToProject(taskID entity.TaskID, userID entity.UserID, projectID *entity.ProjectID) (*entity.Task, error) {
+	args := m.Called(taskID, userID, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Snooze(taskID entity.TaskID, userID entity.UserID, until *time.Time) (*entity.Task, error) {
+	args := m.Called(taskID, userID, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Move(taskID entity.TaskID, userID entity.UserID, status entity.StatusName, prevID *entity.TaskID, nextID *entity.TaskID) (*entity.Task, error) {
+	args := m.Called(taskID, userID, status, prevID, nextID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Delete(taskID entity.TaskID, userID entity.UserID) error {
+	args := m.Called(taskID, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) GetTrash(userID entity.UserID) (*[]entity.Task, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Restore(taskID entity.TaskID, userID entity.UserID) (*entity.Task, error) {
+	args := m.Called(taskID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) DeletePermanently(taskID entity.TaskID, userID entity.UserID) error {
+	args := m.Called(taskID, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) Purge(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockTaskEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaskEventRepository) GetAll(taskID entity.TaskID, userID entity.UserID, query *entity.TaskEventQuery) (*entity.TaskEventPage, error) {
+	args := m.Called(taskID, userID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TaskEventPage), args.Error(1)
+}
+
+type TaskUsecaseSuite struct {
+	suite.Suite
+	tr  *MockTaskRepository
+	er  *MockTaskEventRepository
+	ar  *MockAttachmentRepository
+	bs  *MockBlobStorage
+	ter *MockTimeEntryRepository
+	tu  ITaskUsecase
+}
+
+func TestTaskUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(TaskUsecaseSuite))
+}
+
+func (suite *TaskUsecaseSuite) SetupTest() {
+	suite.tr = new(MockTaskRepository)
+	suite.er = new(MockTaskEventRepository)
+	suite.ar = new(MockAttachmentRepository)
+	suite.bs = new(MockBlobStorage)
+	suite.ter = new(MockTimeEntryRepository)
+	suite.tu = NewTaskUsecase(suite.tr, suite.er, suite.ar, suite.bs, suite.ter)
+}
+
+func (suite *TaskUsecaseSuite) TestSaveCreatesNextOccurrence() {
+	rule, _ := entity.ParseRecurrenceRule("FREQ=MONTHLY;COUNT=3")
+	deadline := pkg.Str2time("2025-01-31")
+	current := &entity.Task{ID: 1, UserID: 1, Name: "invoice", Status: entity.Status{Name: entity.Todo}, Recurrence: rule, Deadline: &deadline}
+	done := &entity.Task{
+		ID: 1, UserID: 1, Name: "invoice", Priority: entity.PriorityHigh,
+		Status:         entity.Status{Name: entity.Done},
+		Tags:           []entity.Tag{{ID: 5, Name: "billing"}},
+		ChecklistItems: []entity.ChecklistItem{{ID: 9, TaskID: 1, Text: "send", Checked: true, Position: 0}},
+		Recurrence:     rule,
+		Deadline:       &deadline,
+	}
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(current, nil)
+	suite.tr.On("Save", mock.Anything, mock.Anything).Return(done, nil)
+	suite.tr.On("Create", mock.MatchedBy(func(task *entity.Task) bool {
+		return task.Name == "invoice" &&
+			task.Priority == entity.PriorityHigh &&
+			task.Status.Name == entity.Todo &&
+			task.Deadline.Equal(pkg.Str2time("2025-02-28")) &&
+			task.Recurrence.String() == "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=2" &&
+			len(task.Tags) == 1 && task.Tags[0].ID == 5 &&
+			len(task.ChecklistItems) == 1 && task.ChecklistItems[0].ID == 0 && !task.ChecklistItems[0].Checked
+	})).Return(&entity.Task{ID: 2}, nil)
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(nil, entity.ErrTimerNotRunning)
+
+	savedTask, err := suite.tu.Save(&entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}}, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(done, savedTask)
+	suite.tr.AssertNumberOfCalls(suite.T(), "Create", 1)
+}
+
+func (suite *TaskUsecaseSuite) TestSaveWithoutTransitionToDone() {
+	rule, _ := entity.ParseRecurrenceRule("FREQ=DAILY")
+	deadline := pkg.Str2time("2025-01-31")
+	done := &entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}, Recurrence: rule, Deadline: &deadline}
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(done, nil)
+	suite.tr.On("Save", mock.Anything, mock.Anything).Return(done, nil)
+
+	_, err := suite.tu.Save(&entity.Task{ID: 1, UserID: 1, Name: "renamed", Status: entity.Status{Name: entity.Done}}, nil)
+	suite.Assert().Nil(err)
+	suite.tr.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *TaskUsecaseSuite) TestSaveWhenRecurrenceIsOver() {
+	rule, _ := entity.ParseRecurrenceRule("FREQ=DAILY;COUNT=1")
+	deadline := pkg.Str2time("2025-01-31")
+	current := &entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.InProgress}, Recurrence: rule, Deadline: &deadline}
+	done := &entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}, Recurrence: rule, Deadline: &deadline}
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(current, nil)
+	suite.tr.On("Save", mock.Anything, mock.Anything).Return(done, nil)
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(nil, entity.ErrTimerNotRunning)
+
+	_, err := suite.tu.Save(&entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}}, nil)
+	suite.Assert().Nil(err)
+	suite.tr.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *TaskUsecaseSuite) TestSaveToDoneStopsTimer() {
+	current := &entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.InProgress}}
+	done := &entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}}
+	running := &entity.TimeEntry{ID: 3, TaskID: 1, UserID: 1, StartedAt: time.Now().Add(-time.Minute)}
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(current, nil)
+	suite.tr.On("Save", mock.Anything, mock.Anything).Return(done, nil)
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(running, nil)
+	suite.ter.On("Save", mock.MatchedBy(func(entry *entity.TimeEntry) bool {
+		return entry.ID == 3 && !entry.IsRunning()
+	})).Return(running, nil)
+
+	_, err := suite.tu.Save(&entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}}, nil)
+	suite.Assert().Nil(err)
+	suite.ter.AssertNumberOfCalls(suite.T(), "Save", 1)
+}
+
+func (suite *TaskUsecaseSuite) TestSaveToDoneKeepsTimerOfAnotherTask() {
+	current := &entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.InProgress}}
+	done := &entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}}
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(current, nil)
+	suite.tr.On("Save", mock.Anything, mock.Anything).Return(done, nil)
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(&entity.TimeEntry{ID: 3, TaskID: 2, UserID: 1, StartedAt: time.Now()}, nil)
+
+	_, err := suite.tu.Save(&entity.Task{ID: 1, UserID: 1, Status: entity.Status{Name: entity.Done}}, nil)
+	suite.Assert().Nil(err)
+	suite.ter.AssertNotCalled(suite.T(), "Save", mock.Anything)
+}
+
+func (suite *TaskUsecaseSuite) TestSaveRejectsBlockedTransition() {
+	current := &entity.Task{
+		ID: 2, UserID: 1, Status: entity.Status{Name: entity.Todo},
+		Blockers: []entity.TaskDependency{
+			{TaskID: 2, BlockerID: 1, Blocker: entity.Task{ID: 1, Status: entity.Status{Name: entity.InProgress}}},
+		},
+	}
+	suite.tr.On("Get", entity.TaskID(2), entity.UserID(1)).Return(current, nil)
+
+	for _, status := range []entity.StatusName{entity.InProgress, entity.Done} {
+		_, err := suite.tu.Save(&entity.Task{ID: 2, UserID: 1, Status: entity.Status{Name: status}}, nil)
+		suite.Assert().ErrorIs(err, entity.ErrTaskBlocked)
+	}
+	suite.tr.AssertNotCalled(suite.T(), "Save", mock.Anything, mock.Anything)
+
+	// other statuses and changes that keep the status are allowed
+	suite.tr.On("Save", mock.Anything, mock.Anything).Return(current, nil)
+	_, err := suite.tu.Save(&entity.Task{ID: 2, UserID: 1, Status: entity.Status{Name: entity.Pending}}, nil)
+	suite.Assert().Nil(err)
+	_, err = suite.tu.Save(&entity.Task{ID: 2, UserID: 1, Name: "renamed", Status: entity.Status{Name: entity.Todo}}, nil)
+	suite.Assert().Nil(err)
+}
+
+func (suite *TaskUsecaseSuite) TestMoveToDoneCreatesNextOccurrence() {
+	rule, _ := entity.ParseRecurrenceRule("FREQ=DAILY")
+	deadline := pkg.Str2time("2025-01-31")
+	current := &entity.Task{ID: 1, UserID: 1, Name: "standup", Status: entity.Status{Name: entity.InProgress}, Recurrence: rule, Deadline: &deadline}
+	done := &entity.Task{ID: 1, UserID: 1, Name: "standup", Status: entity.Status{Name: entity.Done}, Recurrence: rule, Deadline: &deadline}
+	prevID := entity.TaskID(7)
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(current, nil)
+	suite.tr.On("Move", entity.TaskID(1), entity.UserID(1), entity.Done, &prevID, (*entity.TaskID)(nil)).Return(done, nil)
+	suite.tr.On("Create", mock.MatchedBy(func(task *entity.Task) bool {
+		return task.Name == "standup" && task.Deadline.Equal(pkg.Str2time("2025-02-01"))
+	})).Return(&entity.Task{ID: 2}, nil)
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(nil, entity.ErrTimerNotRunning)
+
+	movedTask, err := suite.tu.Move(1, 1, entity.Done, &prevID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(done, movedTask)
+	suite.tr.AssertNumberOfCalls(suite.T(), "Create", 1)
+}
+
+func (suite *TaskUsecaseSuite) TestMoveToDoneKeepsDueTimeOfNextOccurrence() {
+	rule, _ := entity.ParseRecurrenceRule("FREQ=WEEKLY")
+	deadline := pkg.Str2time("2025-03-03")
+	current := &entity.Task{ID: 1, UserID: 1, Name: "report", Status: entity.Status{Name: entity.InProgress}, Recurrence: rule}
+	suite.Require().Nil(current.SetDeadline(&deadline, "17:00", "America/New_York"))
+	done := &entity.Task{ID: 1, UserID: 1, Name: "report", Status: entity.Status{Name: entity.Done}, Recurrence: rule}
+	suite.Require().Nil(done.SetDeadline(&deadline, "17:00", "America/New_York"))
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(current, nil)
+	suite.tr.On("Move", entity.TaskID(1), entity.UserID(1), entity.Done, (*entity.TaskID)(nil), (*entity.TaskID)(nil)).Return(done, nil)
+	// test the due time stays 17:00 in the zone across the daylight saving time change
+	suite.tr.On("Create", mock.MatchedBy(func(task *entity.Task) bool {
+		return task.Deadline.Equal(pkg.Str2time("2025-03-10")) &&
+			task.TimeZone == "America/New_York" &&
+			task.DueAt.Equal(time.Date(2025, 3, 10, 21, 0, 0, 0, time.UTC))
+	})).Return(&entity.Task{ID: 2}, nil)
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(nil, entity.ErrTimerNotRunning)
+
+	_, err := suite.tu.Move(1, 1, entity.Done, nil, nil)
+	suite.Assert().Nil(err)
+	suite.tr.AssertNumberOfCalls(suite.T(), "Create", 1)
+}
+
+func (suite *TaskUsecaseSuite) TestMoveToDoneKeepsProjectOfNextOccurrence() {
+	rule, _ := entity.ParseRecurrenceRule("FREQ=WEEKLY")
+	deadline := pkg.Str2time("2025-01-31")
+	startAt := pkg.Str2time("2025-01-29")
+	projectID := entity.ProjectID(5)
+	current := &entity.Task{
+		ID: 1, UserID: 1, Name: "review", Status: entity.Status{Name: entity.InProgress}, Recurrence: rule, Deadline: &deadline,
+		ProjectID: &projectID, Estimate: &entity.Estimate{Value: 3, Unit: entity.EstimatePoints}, StartAt: &startAt,
+	}
+	done := *current
+	done.Status = entity.Status{Name: entity.Done}
+
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(current, nil)
+	suite.tr.On("Move", entity.TaskID(1), entity.UserID(1), entity.Done, (*entity.TaskID)(nil), (*entity.TaskID)(nil)).Return(&done, nil)
+	// test the next occurrence stays in the project and its start date moves with the deadline
+	suite.tr.On("Create", mock.MatchedBy(func(task *entity.Task) bool {
+		return task.ProjectID != nil && *task.ProjectID == projectID &&
+			task.Estimate != nil && *task.Estimate == entity.Estimate{Value: 3, Unit: entity.EstimatePoints} &&
+			task.StartAt != nil && task.StartAt.Equal(pkg.Str2time("2025-02-05")) &&
+			task.Deadline.Equal(pkg.Str2time("2025-02-07"))
+	})).Return(&entity.Task{ID: 2}, nil)
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(nil, entity.ErrTimerNotRunning)
+
+	_, err := suite.tu.Move(1, 1, entity.Done, nil, nil)
+	suite.Assert().Nil(err)
+	suite.tr.AssertNumberOfCalls(suite.T(), "Create", 1)
+}
+
+func (suite *TaskUsecaseSuite) TestMoveRejectsBlockedTransition() {
+	current := &entity.Task{
+		ID: 2, UserID: 1, Status: entity.Status{Name: entity.Todo},
+		Blockers: []entity.TaskDependency{
+			{TaskID: 2, BlockerID: 1, Blocker: entity.Task{ID: 1, Status: entity.Status{Name: entity.Todo}}},
+		},
+	}
+	suite.tr.On("Get", entity.TaskID(2), entity.UserID(1)).Return(current, nil)
+
+	_, err := suite.tu.Move(2, 1, entity.InProgress, nil, nil)
+	suite.Assert().ErrorIs(err, entity.ErrTaskBlocked)
+	suite.tr.AssertNotCalled(suite.T(), "Move", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// reordering within the current column is allowed
+	suite.tr.On("Move", entity.TaskID(2), entity.UserID(1), entity.Todo, (*entity.TaskID)(nil), (*entity.TaskID)(nil)).Return(current, nil)
+	_, err = suite.tu.Move(2, 1, entity.Todo, nil, nil)
+	suite.Assert().Nil(err)
+}
+
+func (suite *TaskUsecaseSuite) TestPurgeTrash() {
+	suite.tr.On("Purge", mock.MatchedBy(func(before time.Time) bool {
+		cutoff := time.Now().AddDate(0, 0, -30)
+		return before.After(cutoff.Add(-time.Minute)) && before.Before(cutoff.Add(time.Minute))
+	})).Return(int64(3), nil)
+	suite.ar.On("GetOrphans").Return(&[]entity.Attachment{}, nil)
+
+	purged, err := suite.tu.PurgeTrash(30 * 24 * time.Hour)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(3), purged)
+}
+
+func (suite *TaskUsecaseSuite) TestSnoozeRejectsPastTime() {
+	past := time.Now().Add(-time.Minute)
+	suite.tr.On("Get", entity.TaskID(1), entity.UserID(1)).Return(&entity.Task{ID: 1, UserID: 1}, nil)
+
+	_, err := suite.tu.Snooze(1, 1, &past)
+	suite.Assert().ErrorIs(err, entity.ErrInvalidSnooze)
+	suite.tr.AssertNotCalled(suite.T(), "Snooze", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaskUsecaseSuite) TestDeletePermanentlyRemovesAttachments() {
+	suite.tr.On("DeletePermanently", entity.TaskID(1), entity.UserID(1)).Return(nil)
+	suite.ar.On("GetOrphans").Return(&[]entity.Attachment{{ID: 4, StorageKey: "tasks/1/a"}, {ID: 5, StorageKey: "tasks/1/b"}}, nil)
+	suite.bs.On("Delete", "tasks/1/a").Return(nil)
+	suite.bs.On("Delete", "tasks/1/b").Return(errors.New("storage error"))
+	suite.ar.On("Delete", entity.AttachmentID(4)).Return(nil)
+
+	// the attachment whose file could not be deleted is kept for the next cleanup
+	suite.Assert().NotNil(suite.tu.DeletePermanently(1, 1))
+	suite.ar.AssertCalled(suite.T(), "Delete", entity.AttachmentID(4))
+	suite.ar.AssertNotCalled(suite.T(), "Delete", entity.AttachmentID(5))
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"time"
+)
+
+type ITimeEntryUsecase interface {
+	Start(taskID entity.TaskID, userID entity.UserID) (*entity.TimeEntry, error)
+	Stop(userID entity.UserID) (*entity.TimeEntry, error)
+	GetRunning(userID entity.UserID) (*entity.TimeEntry, error)
+	Create(entry *entity.TimeEntry) (*entity.TimeEntry, error)
+	GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.TimeEntry, error)
+	Save(entry *entity.TimeEntry) (*entity.TimeEntry, error)
+	Delete(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) error
+}
+
+type timeEntryUsecase struct {
+	ter gateway.ITimeEntryRepository
+}
+
+func NewTimeEntryUsecase(ter gateway.ITimeEntryRepository) ITimeEntryUsecase {
+	return &timeEntryUsecase{ter: ter}
+}
+
+// Start はタスクのタイマーを開始する。既に別のタイマーが動いていれば ErrTimerAlreadyRunning
+func (teu *timeEntryUsecase) Start(taskID entity.TaskID, userID entity.UserID) (*entity.TimeEntry, error) {
+	return teu.ter.Create(&entity.TimeEntry{TaskID: taskID, UserID: userID, StartedAt: time.Now()})
+}
+
+// Stop は計測中のタイマーを止める。動いていなければ ErrTimerNotRunning
+func (teu *timeEntryUsecase) Stop(userID entity.UserID) (*entity.TimeEntry, error) {
+	entry, err := teu.ter.GetRunning(userID)
+	if err != nil {
+		return nil, err
+	}
+	return stopTimer(teu.ter, entry)
+}
+
+func stopTimer(ter gateway.ITimeEntryRepository, entry *entity.TimeEntry) (*entity.TimeEntry, error) {
+	if err := entry.Finish(time.Now()); err != nil {
+		return nil, err
+	}
+	return ter.Save(entry)
+}
+
+func (teu *timeEntryUsecase) GetRunning(userID entity.UserID) (*entity.TimeEntry, error) {
+	return teu.ter.GetRunning(userID)
+}
+
+// Create は終了日時を指定して作業時間を手動で記録する
+func (teu *timeEntryUsecase) Create(entry *entity.TimeEntry) (*entity.TimeEntry, error) {
+	if entry.EndedAt == nil {
+		return nil, entity.ErrInvalidTimeRange
+	}
+	if err := entry.Finish(*entry.EndedAt); err != nil {
+		return nil, err
+	}
+	return teu.ter.Create(entry)
+}
+
+func (teu *timeEntryUsecase) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.TimeEntry, error) {
+	return teu.ter.GetAll(taskID, userID)
+}
+
+// Save は指定された項目だけを更新する。開始日時がゼロ値、終了日時が nil、メモが空の場合はそのまま。
+// 計測中の記録に終了日時を指定するとタイマーが止まる
+func (teu *timeEntryUsecase) Save(entry *entity.TimeEntry) (*entity.TimeEntry, error) {
+	currentEntry, err := teu.ter.Get(entry.ID, entry.TaskID, entry.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !entry.StartedAt.IsZero() {
+		currentEntry.StartedAt = entry.StartedAt
+	}
+	if entry.Note != "" {
+		currentEntry.Note = entry.Note
+	}
+	endedAt := entry.EndedAt
+	if endedAt == nil {
+		endedAt = currentEntry.EndedAt
+	}
+	// 開始日時が変わると作業時間も変わるため、終了済みの記録は計算し直す
+	if endedAt != nil {
+		if err := currentEntry.Finish(*endedAt); err != nil {
+			return nil, err
+		}
+	}
+	return teu.ter.Save(currentEntry)
+}
+
+func (teu *timeEntryUsecase) Delete(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) error {
+	return teu.ter.Delete(entryID, taskID, userID)
+}
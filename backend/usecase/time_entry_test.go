@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"backend/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockTimeEntryRepository struct {
+	mock.Mock
+}
+
+func (m *MockTimeEntryRepository) Create(entry *entity.TimeEntry) (*entity.TimeEntry, error) {
+	args := m.Called(entry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TimeEntry), args.Error(1)
+}
+
+func (m *MockTimeEntryRepository) Get(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) (*entity.TimeEntry, error) {
+	args := m.Called(entryID, taskID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TimeEntry), args.Error(1)
+}
+
+func (m *MockTimeEntryRepository) GetAll(taskID entity.TaskID, userID entity.UserID) (*[]entity.TimeEntry, error) {
+	args := m.Called(taskID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entity.TimeEntry), args.Error(1)
+}
+
+func (m *MockTimeEntryRepository) GetRunning(userID entity.UserID) (*entity.TimeEntry, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TimeEntry), args.Error(1)
+}
+
+func (m *MockTimeEntryRepository) Save(entry *entity.TimeEntry) (*entity.TimeEntry, error) {
+	args := m.Called(entry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TimeEntry), args.Error(1)
+}
+
+func (m *MockTimeEntryRepository) Delete(entryID entity.TimeEntryID, taskID entity.TaskID, userID entity.UserID) error {
+	args := m.Called(entryID, taskID, userID)
+	return args.Error(0)
+}
+
+type TimeEntryUsecaseSuite struct {
+	suite.Suite
+	ter *MockTimeEntryRepository
+	teu ITimeEntryUsecase
+}
+
+func TestTimeEntryUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(TimeEntryUsecaseSuite))
+}
+
+func (suite *TimeEntryUsecaseSuite) SetupTest() {
+	suite.ter = new(MockTimeEntryRepository)
+	suite.teu = NewTimeEntryUsecase(suite.ter)
+}
+
+func (suite *TimeEntryUsecaseSuite) TestStop() {
+	running := &entity.TimeEntry{ID: 1, TaskID: 2, UserID: 1, StartedAt: time.Now().Add(-time.Hour)}
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(running, nil)
+	suite.ter.On("Save", running).Return(running, nil)
+
+	entry, err := suite.teu.Stop(1)
+	suite.Assert().Nil(err)
+	suite.Assert().False(entry.IsRunning())
+	suite.Assert().InDelta(3600, entry.DurationSeconds, 5)
+}
+
+func (suite *TimeEntryUsecaseSuite) TestStopWithoutTimer() {
+	suite.ter.On("GetRunning", entity.UserID(1)).Return(nil, entity.ErrTimerNotRunning)
+
+	_, err := suite.teu.Stop(1)
+	suite.Assert().ErrorIs(err, entity.ErrTimerNotRunning)
+	suite.ter.AssertNotCalled(suite.T(), "Save", mock.Anything)
+}
+
+func (suite *TimeEntryUsecaseSuite) TestCreate() {
+	startedAt := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(45 * time.Minute)
+	suite.ter.On("Create", mock.MatchedBy(func(entry *entity.TimeEntry) bool {
+		return entry.DurationSeconds == 2700
+	})).Return(&entity.TimeEntry{ID: 1}, nil)
+
+	_, err := suite.teu.Create(&entity.TimeEntry{TaskID: 2, UserID: 1, StartedAt: startedAt, EndedAt: &endedAt})
+	suite.Assert().Nil(err)
+
+	// test an entry ending before it starts is rejected
+	_, err = suite.teu.Create(&entity.TimeEntry{TaskID: 2, UserID: 1, StartedAt: endedAt, EndedAt: &startedAt})
+	suite.Assert().ErrorIs(err, entity.ErrInvalidTimeRange)
+	suite.ter.AssertNumberOfCalls(suite.T(), "Create", 1)
+}
+
+func (suite *TimeEntryUsecaseSuite) TestSave() {
+	startedAt := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(time.Hour)
+	current := &entity.TimeEntry{ID: 1, TaskID: 2, UserID: 1, StartedAt: startedAt, EndedAt: &endedAt, DurationSeconds: 3600, Note: "meeting"}
+	suite.ter.On("Get", entity.TimeEntryID(1), entity.TaskID(2), entity.UserID(1)).Return(current, nil)
+	suite.ter.On("Save", current).Return(current, nil)
+
+	// test changing the start recalculates the duration and keeps the note
+	savedEntry, err := suite.teu.Save(&entity.TimeEntry{ID: 1, TaskID: 2, UserID: 1, StartedAt: startedAt.Add(30 * time.Minute)})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(int64(1800), savedEntry.DurationSeconds)
+	suite.Assert().Equal("meeting", savedEntry.Note)
+
+	// test a start after the end is rejected
+	_, err = suite.teu.Save(&entity.TimeEntry{ID: 1, TaskID: 2, UserID: 1, StartedAt: endedAt.Add(time.Minute)})
+	suite.Assert().ErrorIs(err, entity.ErrInvalidTimeRange)
+}
@@ -4,6 +4,7 @@ import (
 	"backend/adapter/gateway"
 	"backend/entity"
 	"backend/pkg/logger"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -14,18 +15,29 @@ import (
 
 type IUserUsecase interface {
 	SignUp(user *entity.User) (*entity.User, error)
-	Login(user *entity.User) (string, error)
+	Login(user *entity.User, device string) (*entity.AuthTokens, error)
+	Refresh(refreshToken string) (*entity.AuthTokens, error)
+	Logout(accessToken string) error
 }
 
 type userUsecase struct {
-	ur gateway.IUserRepository
+	ur     gateway.IUserRepository
+	sr     gateway.ISessionRepository
+	trs    gateway.ITokenRevocationStore
+	mailer gateway.IMailer
+	appURL string
 }
 
-func NewUserUsecase(ur gateway.IUserRepository) IUserUsecase {
-	return &userUsecase{ur: ur}
+func NewUserUsecase(ur gateway.IUserRepository, sr gateway.ISessionRepository, trs gateway.ITokenRevocationStore, mailer gateway.IMailer, appURL string) IUserUsecase {
+	return &userUsecase{ur: ur, sr: sr, trs: trs, mailer: mailer, appURL: appURL}
 }
 
+// SignUp はユーザーを作り、メールアドレスの確認メールを送る。メールを送れなくても登録は成功させ、再送で送り直せるようにする
 func (uu *userUsecase) SignUp(user *entity.User) (*entity.User, error) {
+	if err := entity.ValidateEmail(user.Email); err != nil {
+		return nil, err
+	}
+
 	hash, err := bcrypt.GenerateFromPassword([]byte(user.Password), 10)
 	if err != nil {
 		logger.Error("Failed to hash password: " + err.Error())
@@ -35,16 +47,32 @@ func (uu *userUsecase) SignUp(user *entity.User) (*entity.User, error) {
 	newUser := entity.User{
 		Email:    user.Email,
 		Password: string(hash),
+		TimeZone: user.TimeZone,
 	}
 
-	return uu.ur.Create(&newUser)
+	createdUser, err := uu.ur.Create(&newUser)
+	if err != nil {
+		return nil, err
+	}
+	// 送れたときだけ送信時刻を残し、送れなかったときはすぐに再送できるようにする
+	now := time.Now()
+	sendVerificationMail(uu.mailer, uu.appURL, createdUser, now, func(err error) {
+		if err != nil {
+			return
+		}
+		if err := uu.ur.SetVerificationSentAt(createdUser.ID, &now); err != nil {
+			logger.Error(fmt.Sprintf("Failed to record verification send of user %d: %s", createdUser.ID, err.Error()))
+		}
+	})
+	return createdUser, nil
 }
 
-func (uu *userUsecase) Login(user *entity.User) (string, error) {
+// Login は端末 device の新しいセッションを作り、アクセストークンとリフレッシュトークンを発行する
+func (uu *userUsecase) Login(user *entity.User, device string) (*entity.AuthTokens, error) {
 	storedUser, err := uu.ur.GetByEmail(user.Email)
 	if err != nil {
 		logger.Error("GetByEmail failed: " + err.Error())
-		return "", err
+		return nil, err
 	}
 
 	logger.Info(fmt.Sprintf("storedUser: ID=%d, Email=%s", storedUser.ID, storedUser.Email))
@@ -52,23 +80,121 @@ func (uu *userUsecase) Login(user *entity.User) (string, error) {
 	err = bcrypt.CompareHashAndPassword([]byte(storedUser.Password), []byte(user.Password))
 	if err != nil {
 		logger.Error("Password mismatch: " + err.Error())
-		return "", err
+		return nil, err
+	}
+
+	now := time.Now()
+	refreshToken, rawRefreshToken, err := entity.NewRefreshToken(0, now)
+	if err != nil {
+		return nil, err
+	}
+	session, err := uu.sr.Create(entity.NewSession(storedUser.ID, device, now), refreshToken)
+	if err != nil {
+		return nil, err
 	}
 
 	logger.Info(fmt.Sprintf("Creating token with user_id: %d", storedUser.ID))
+	return newAuthTokens(storedUser.ID, session.ID, refreshToken, rawRefreshToken, now)
+}
+
+// Refresh はリフレッシュトークンを新しいものに交換し、アクセストークンを発行し直す。
+// 使用済みのトークンが使われた場合は盗まれた可能性があるため、そのセッションを失効させる
+func (uu *userUsecase) Refresh(rawRefreshToken string) (*entity.AuthTokens, error) {
+	now := time.Now()
+	usedToken, err := uu.sr.GetRefreshToken(entity.HashToken(rawRefreshToken))
+	if err != nil {
+		return nil, err
+	}
+	session := usedToken.Session
+	if !session.IsActive(now) || !now.Before(usedToken.ExpiresAt) {
+		return nil, entity.ErrInvalidRefreshToken
+	}
 
+	refreshToken, rawNextToken, err := entity.NewRefreshToken(session.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if usedToken.UsedAt != nil {
+		err = entity.ErrRefreshTokenReused
+	} else {
+		err = uu.sr.Rotate(usedToken, refreshToken, now)
+	}
+	if errors.Is(err, entity.ErrRefreshTokenReused) {
+		logger.Warn(fmt.Sprintf("Refresh token reused, revoking session: %d", session.ID))
+		if err := uu.sr.Revoke(session.ID, session.UserID, now); err != nil {
+			return nil, err
+		}
+		return nil, entity.ErrRefreshTokenReused
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newAuthTokens(session.UserID, session.ID, refreshToken, rawNextToken, now)
+}
+
+// Logout はアクセストークンを有効期限まで無効にし、そのトークンのセッションも失効させる。
+// 署名が正しくないトークンは何もせず、Cookie の削除だけでログアウトしたことにする
+func (uu *userUsecase) Logout(accessToken string) error {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+	if _, err := parser.ParseWithClaims(accessToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(os.Getenv("SECRET")), nil
+	}); err != nil {
+		logger.Warn("Logout with invalid token: " + err.Error())
+		return nil
+	}
+
+	now := time.Now()
+	jti, hasJTI := claims["jti"].(string)
+	exp, hasExp := claims["exp"].(float64)
+	// 期限切れのトークンはもともと使えないので記録しない
+	if hasJTI && hasExp && now.Before(time.Unix(int64(exp), 0)) {
+		if err := uu.trs.Revoke(jti, time.Unix(int64(exp), 0)); err != nil {
+			return err
+		}
+	}
+
+	sessionID, hasSessionID := claims["sid"].(float64)
+	userID, hasUserID := claims["user_id"].(float64)
+	if hasSessionID && hasUserID {
+		return uu.sr.Revoke(entity.SessionID(sessionID), entity.UserID(userID), now)
+	}
+	return nil
+}
+
+// newAuthTokens はセッションに紐づく有効期限の短いアクセストークンを署名し、リフレッシュトークンと合わせて返す
+func newAuthTokens(userID entity.UserID, sessionID entity.SessionID, refreshToken *entity.RefreshToken, rawRefreshToken string, now time.Time) (*entity.AuthTokens, error) {
+	accessExpiresAt := now.Add(entity.AccessTokenLifetime)
+	// jti はログアウトしたトークンを無効にするための ID
+	jti, err := entity.NewRandomToken()
+	if err != nil {
+		return nil, err
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": storedUser.ID,
-		"exp":     time.Now().Add(time.Hour * 12).Unix(),
+		"jti":     jti,
+		"user_id": userID,
+		"sid":     sessionID,
+		"iat":     now.Unix(),
+		"exp":     accessExpiresAt.Unix(),
 	})
 	tokenString, err := token.SignedString([]byte(os.Getenv("SECRET")))
 	if err != nil {
 		logger.Error("Failed to sign token: " + err.Error())
-		return "", err
+		return nil, err
 	}
 
 	logger.Info("Token created successfully")
-	return tokenString, nil
+	return &entity.AuthTokens{
+		AccessToken:      tokenString,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     rawRefreshToken,
+		RefreshExpiresAt: refreshToken.ExpiresAt,
+		SessionID:        sessionID,
+	}, nil
 }
 
 func (uu *userUsecase) Save(user *entity.User) (*entity.User, error) {
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"errors"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type MockSessionRepository struct {
+	mock.Mock
+}
+
+func (m *MockSessionRepository) Create(session *entity.Session, token *entity.RefreshToken) (*entity.Session, error) {
+	args := m.Called(session, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) GetRefreshToken(tokenHash string) (*entity.RefreshToken, error) {
+	args := m.Called(tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.RefreshToken), args.Error(1)
+}
+
+func (m *MockSessionRepository) Rotate(used *entity.RefreshToken, next *entity.RefreshToken, now time.Time) error {
+	args := m.Called(used, next, now)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) GetAll(userID entity.UserID, now time.Time) (*[]entity.Session, error) {
+	args := m.Called(userID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entity.Session), args.Error(1)
+}
+
+func (m *MockSessionRepository) IsActive(sessionID entity.SessionID, userID entity.UserID, now time.Time) (bool, error) {
+	args := m.Called(sessionID, userID, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSessionRepository) Revoke(sessionID entity.SessionID, userID entity.UserID, now time.Time) error {
+	args := m.Called(sessionID, userID, now)
+	return args.Error(0)
+}
+
+func (m *MockSessionRepository) RevokeAll(userID entity.UserID, now time.Time) error {
+	args := m.Called(userID, now)
+	return args.Error(0)
+}
+
+type UserUsecaseSuite struct {
+	suite.Suite
+	ur     *MockUserRepository
+	sr     *MockSessionRepository
+	trs    gateway.ITokenRevocationStore
+	mailer *MockMailer
+	uu     IUserUsecase
+}
+
+func TestUserUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(UserUsecaseSuite))
+}
+
+func (suite *UserUsecaseSuite) SetupTest() {
+	suite.T().Setenv("SECRET", "secret")
+	suite.ur = new(MockUserRepository)
+	suite.sr = new(MockSessionRepository)
+	suite.trs = gateway.NewMemoryTokenRevocationStore()
+	suite.mailer = new(MockMailer)
+	suite.uu = NewUserUsecase(suite.ur, suite.sr, suite.trs, suite.mailer, "https://app.test")
+}
+
+// refreshToken は sessionID のセッションに属する、使用済みかどうかが used のリフレッシュトークンを返す
+func refreshToken(sessionID entity.SessionID, used bool) *entity.RefreshToken {
+	now := time.Now()
+	token := &entity.RefreshToken{
+		ID:        1,
+		SessionID: sessionID,
+		Session:   *entity.NewSession(1, "laptop", now),
+		ExpiresAt: now.Add(time.Hour),
+	}
+	token.Session.ID = sessionID
+	if used {
+		token.UsedAt = &now
+	}
+	return token
+}
+
+func (suite *UserUsecaseSuite) TestSignUpSendsVerificationMail() {
+	// test invalid emails are rejected
+	_, err := suite.uu.SignUp(&entity.User{Email: "not an email", Password: "password"})
+	suite.Assert().ErrorIs(err, entity.ErrInvalidEmail)
+	suite.ur.AssertNotCalled(suite.T(), "Create", mock.Anything)
+
+	suite.ur.On("Create", mock.MatchedBy(func(user *entity.User) bool {
+		return user.Email == "user@test.com" && user.VerificationSentAt == nil && !user.IsEmailVerified()
+	})).Return(&entity.User{ID: 1, Email: "user@test.com"}, nil)
+	recorded := make(chan *time.Time, 1)
+	suite.ur.On("SetVerificationSentAt", entity.UserID(1), mock.Anything).Run(func(args mock.Arguments) {
+		recorded <- args.Get(1).(*time.Time)
+	}).Return(nil)
+	mails := make(chan *entity.Mail, 1)
+	suite.mailer.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mails <- args.Get(1).(*entity.Mail)
+	}).Return(nil)
+
+	user, err := suite.uu.SignUp(&entity.User{Email: "user@test.com", Password: "password"})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.UserID(1), user.ID)
+	sent := receiveMail(suite.T(), mails)
+	suite.Assert().Equal("user@test.com", sent.To)
+	suite.Assert().Contains(sent.Body, "https://app.test/verify-email?token=")
+
+	// test the send time is recorded once the mail is sent
+	select {
+	case sentAt := <-recorded:
+		suite.Assert().NotNil(sentAt)
+	case <-time.After(time.Second):
+		suite.T().Fatal("send time was not recorded")
+	}
+}
+
+func (suite *UserUsecaseSuite) TestSignUpLeavesResendOpenWhenMailFails() {
+	suite.ur.On("Create", mock.Anything).Return(&entity.User{ID: 1, Email: "user@test.com"}, nil)
+	mails := make(chan *entity.Mail, 1)
+	suite.mailer.On("Send", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mails <- args.Get(1).(*entity.Mail)
+	}).Return(errors.New("smtp down"))
+
+	// test signup succeeds and no send time is recorded
+	_, err := suite.uu.SignUp(&entity.User{Email: "user@test.com", Password: "password"})
+	suite.Assert().Nil(err)
+	receiveMail(suite.T(), mails)
+	time.Sleep(10 * time.Millisecond)
+	suite.ur.AssertNotCalled(suite.T(), "SetVerificationSentAt", mock.Anything, mock.Anything)
+}
+
+func (suite *UserUsecaseSuite) TestLoginCreatesSession() {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	suite.ur.On("GetByEmail", "user@test.com").Return(&entity.User{ID: 1, Email: "user@test.com", Password: string(hash)}, nil)
+	suite.sr.On("Create", mock.MatchedBy(func(session *entity.Session) bool {
+		return session.UserID == 1 && session.Device == "laptop"
+	}), mock.Anything).Return(&entity.Session{ID: 7, UserID: 1}, nil)
+
+	tokens, err := suite.uu.Login(&entity.User{Email: "user@test.com", Password: "password"}, "laptop")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.SessionID(7), tokens.SessionID)
+	suite.Assert().NotEmpty(tokens.RefreshToken)
+
+	// test the access token is short-lived and bound to the session
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(tokens.AccessToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte("secret"), nil
+	})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(float64(7), claims["sid"])
+	suite.Assert().Equal(float64(1), claims["user_id"])
+	suite.Assert().NotEmpty(claims["jti"])
+	suite.Assert().WithinDuration(time.Now().Add(entity.AccessTokenLifetime), tokens.AccessExpiresAt, time.Minute)
+}
+
+func (suite *UserUsecaseSuite) TestRefreshRotatesToken() {
+	used := refreshToken(7, false)
+	suite.sr.On("GetRefreshToken", entity.HashToken("raw")).Return(used, nil)
+	suite.sr.On("Rotate", used, mock.Anything, mock.Anything).Return(nil)
+
+	tokens, err := suite.uu.Refresh("raw")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(entity.SessionID(7), tokens.SessionID)
+	suite.Assert().NotEqual("raw", tokens.RefreshToken)
+	suite.sr.AssertNotCalled(suite.T(), "Revoke", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *UserUsecaseSuite) TestRefreshReuseRevokesSession() {
+	// test a used token revokes the whole session
+	suite.sr.On("GetRefreshToken", entity.HashToken("stolen")).Return(refreshToken(7, true), nil)
+	suite.sr.On("Revoke", entity.SessionID(7), entity.UserID(1), mock.Anything).Return(nil)
+
+	_, err := suite.uu.Refresh("stolen")
+	suite.Assert().ErrorIs(err, entity.ErrRefreshTokenReused)
+	suite.sr.AssertNumberOfCalls(suite.T(), "Revoke", 1)
+	suite.sr.AssertNotCalled(suite.T(), "Rotate", mock.Anything, mock.Anything, mock.Anything)
+
+	// test losing a concurrent rotation is treated as reuse
+	raced := refreshToken(8, false)
+	suite.sr.On("GetRefreshToken", entity.HashToken("raced")).Return(raced, nil)
+	suite.sr.On("Rotate", raced, mock.Anything, mock.Anything).Return(entity.ErrRefreshTokenReused)
+	suite.sr.On("Revoke", entity.SessionID(8), entity.UserID(1), mock.Anything).Return(nil)
+
+	_, err = suite.uu.Refresh("raced")
+	suite.Assert().ErrorIs(err, entity.ErrRefreshTokenReused)
+	suite.sr.AssertNumberOfCalls(suite.T(), "Revoke", 2)
+}
+
+func (suite *UserUsecaseSuite) TestRefreshRejectsRevokedSession() {
+	revoked := refreshToken(7, false)
+	now := time.Now()
+	revoked.Session.RevokedAt = &now
+	suite.sr.On("GetRefreshToken", entity.HashToken("revoked")).Return(revoked, nil)
+
+	_, err := suite.uu.Refresh("revoked")
+	suite.Assert().ErrorIs(err, entity.ErrInvalidRefreshToken)
+	suite.sr.AssertNotCalled(suite.T(), "Rotate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// accessToken は sessionID のセッションのアクセストークンを expiresAt を期限として署名する
+func accessToken(jti string, sessionID entity.SessionID, expiresAt time.Time, secret string) string {
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"jti":     jti,
+		"user_id": 1,
+		"sid":     sessionID,
+		"exp":     expiresAt.Unix(),
+	}).SignedString([]byte(secret))
+	return token
+}
+
+func (suite *UserUsecaseSuite) TestLogoutRevokesToken() {
+	suite.sr.On("Revoke", entity.SessionID(7), entity.UserID(1), mock.Anything).Return(nil)
+
+	// test the token and its session are revoked
+	suite.Assert().Nil(suite.uu.Logout(accessToken("active", 7, time.Now().Add(time.Minute), "secret")))
+	revoked, err := suite.trs.IsRevoked("active")
+	suite.Assert().Nil(err)
+	suite.Assert().True(revoked)
+	suite.sr.AssertNumberOfCalls(suite.T(), "Revoke", 1)
+
+	// test an expired token still ends its session but is not recorded
+	suite.Assert().Nil(suite.uu.Logout(accessToken("expired", 7, time.Now().Add(-time.Minute), "secret")))
+	revoked, err = suite.trs.IsRevoked("expired")
+	suite.Assert().Nil(err)
+	suite.Assert().False(revoked)
+	suite.sr.AssertNumberOfCalls(suite.T(), "Revoke", 2)
+
+	// test a forged token is ignored
+	suite.Assert().Nil(suite.uu.Logout(accessToken("forged", 7, time.Now().Add(time.Minute), "other")))
+	revoked, err = suite.trs.IsRevoked("forged")
+	suite.Assert().Nil(err)
+	suite.Assert().False(revoked)
+	suite.sr.AssertNumberOfCalls(suite.T(), "Revoke", 2)
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"backend/adapter/gateway"
+	"backend/entity"
+	"time"
+)
+
+type IViewUsecase interface {
+	GetSmartList(userID entity.UserID, list entity.SmartList, days int, timeZone string) (*[]entity.Task, error)
+	Create(view *entity.SavedView) (*entity.SavedView, error)
+	Get(viewID entity.SavedViewID, userID entity.UserID) (*entity.SavedView, error)
+	GetAll(userID entity.UserID) (*[]entity.SavedView, error)
+	Save(view *entity.SavedView) (*entity.SavedView, error)
+	Delete(viewID entity.SavedViewID, userID entity.UserID) error
+	GetTasks(viewID entity.SavedViewID, userID entity.UserID, cursor string, limit int) (*entity.TaskPage, error)
+}
+
+type viewUsecase struct {
+	tr  gateway.ITaskRepository
+	ur  gateway.IUserRepository
+	svr gateway.ISavedViewRepository
+}
+
+func NewViewUsecase(tr gateway.ITaskRepository, ur gateway.IUserRepository, svr gateway.ISavedViewRepository) IViewUsecase {
+	return &viewUsecase{tr: tr, ur: ur, svr: svr}
+}
+
+// GetSmartList はスマートリストのタスクを返す。timeZone が空ならユーザーのタイムゾーンで今日の日付を求める
+func (vu *viewUsecase) GetSmartList(userID entity.UserID, list entity.SmartList, days int, timeZone string) (*[]entity.Task, error) {
+	location, err := vu.location(userID, timeZone)
+	if err != nil {
+		return nil, err
+	}
+	query, err := entity.NewSmartListQuery(list, days, location, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return vu.tr.GetSmartList(userID, query)
+}
+
+func (vu *viewUsecase) location(userID entity.UserID, timeZone string) (*time.Location, error) {
+	if timeZone != "" {
+		return entity.LoadTimeZone(timeZone)
+	}
+	user, err := vu.ur.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+	return user.Location(), nil
+}
+
+func (vu *viewUsecase) Create(view *entity.SavedView) (*entity.SavedView, error) {
+	return vu.svr.Create(view)
+}
+
+func (vu *viewUsecase) Get(viewID entity.SavedViewID, userID entity.UserID) (*entity.SavedView, error) {
+	return vu.svr.Get(viewID, userID)
+}
+
+func (vu *viewUsecase) GetAll(userID entity.UserID) (*[]entity.SavedView, error) {
+	return vu.svr.GetAll(userID)
+}
+
+func (vu *viewUsecase) Save(view *entity.SavedView) (*entity.SavedView, error) {
+	return vu.svr.Save(view)
+}
+
+func (vu *viewUsecase) Delete(viewID entity.SavedViewID, userID entity.UserID) error {
+	return vu.svr.Delete(viewID, userID)
+}
+
+// GetTasks は保存した絞り込み条件で GET /tasks と同じようにタスクを取得する。
+// 相対的な期限の範囲は、取得するたびにユーザーのタイムゾーンでの今日から求める
+func (vu *viewUsecase) GetTasks(viewID entity.SavedViewID, userID entity.UserID, cursor string, limit int) (*entity.TaskPage, error) {
+	view, err := vu.svr.Get(viewID, userID)
+	if err != nil {
+		return nil, err
+	}
+	query := *view.Filter
+	query.Cursor = cursor
+	query.Limit = limit
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	if query.DueWithinDays != nil {
+		location, err := vu.location(userID, "")
+		if err != nil {
+			return nil, err
+		}
+		query.ResolveDueWithin(time.Now(), location)
+	}
+	return vu.tr.GetAll(userID, &query)
+}
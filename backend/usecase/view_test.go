@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"backend/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Create(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Get(userID entity.UserID) (*entity.User, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByEmail(email string) (*entity.User, error) {
+	args := m.Called(email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Save(user *entity.User) (*entity.User, error) {
+	args := m.Called(user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Delete(userID entity.UserID) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) VerifyEmail(userID entity.UserID, now time.Time) error {
+	args := m.Called(userID, now)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) ClaimVerificationSend(userID entity.UserID, since time.Time, now time.Time) error {
+	args := m.Called(userID, since, now)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) SetVerificationSentAt(userID entity.UserID, sentAt *time.Time) error {
+	args := m.Called(userID, sentAt)
+	return args.Error(0)
+}
+
+type MockSavedViewRepository struct {
+	mock.Mock
+}
+
+func (m *MockSavedViewRepository) Create(view *entity.SavedView) (*entity.SavedView, error) {
+	args := m.Called(view)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SavedView), args.Error(1)
+}
+
+func (m *MockSavedViewRepository) Get(viewID entity.SavedViewID, userID entity.UserID) (*entity.SavedView, error) {
+	args := m.Called(viewID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SavedView), args.Error(1)
+}
+
+func (m *MockSavedViewRepository) GetAll(userID entity.UserID) (*[]entity.SavedView, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*[]entity.SavedView), args.Error(1)
+}
+
+func (m *MockSavedViewRepository) Save(view *entity.SavedView) (*entity.SavedView, error) {
+	args := m.Called(view)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SavedView), args.Error(1)
+}
+
+func (m *MockSavedViewRepository) Delete(viewID entity.SavedViewID, userID entity.UserID) error {
+	args := m.Called(viewID, userID)
+	return args.Error(0)
+}
+
+type ViewUsecaseSuite struct {
+	suite.Suite
+	tr  *MockTaskRepository
+	ur  *MockUserRepository
+	svr *MockSavedViewRepository
+	vu  IViewUsecase
+}
+
+func TestViewUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(ViewUsecaseSuite))
+}
+
+func (suite *ViewUsecaseSuite) SetupTest() {
+	suite.tr = new(MockTaskRepository)
+	suite.ur = new(MockUserRepository)
+	suite.svr = new(MockSavedViewRepository)
+	suite.vu = NewViewUsecase(suite.tr, suite.ur, suite.svr)
+}
+
+// isTodayIn は今日の日付が location での現在の日付になっているかを確認する
+func isTodayIn(query *entity.SmartListQuery, location *time.Location) bool {
+	local := query.Now.In(location)
+	return query.Today.Equal(time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+func (suite *ViewUsecaseSuite) TestGetSmartListUsesUserTimeZone() {
+	kiritimati, _ := time.LoadLocation("Pacific/Kiritimati")
+	suite.ur.On("Get", entity.UserID(1)).Return(&entity.User{ID: 1, TimeZone: "Pacific/Kiritimati"}, nil)
+	suite.tr.On("GetSmartList", entity.UserID(1), mock.MatchedBy(func(query *entity.SmartListQuery) bool {
+		return query.List == entity.SmartListUpcoming && query.Days == entity.DefaultUpcomingDays && isTodayIn(query, kiritimati)
+	})).Return(&[]entity.Task{}, nil)
+
+	_, err := suite.vu.GetSmartList(1, entity.SmartListUpcoming, 0, "")
+	suite.Assert().Nil(err)
+	suite.tr.AssertNumberOfCalls(suite.T(), "GetSmartList", 1)
+}
+
+func (suite *ViewUsecaseSuite) TestGetSmartListWithTimeZoneOverride() {
+	midway, _ := time.LoadLocation("Pacific/Midway")
+	suite.tr.On("GetSmartList", entity.UserID(1), mock.MatchedBy(func(query *entity.SmartListQuery) bool {
+		return isTodayIn(query, midway)
+	})).Return(&[]entity.Task{}, nil)
+
+	_, err := suite.vu.GetSmartList(1, entity.SmartListToday, 0, "Pacific/Midway")
+	suite.Assert().Nil(err)
+	suite.ur.AssertNotCalled(suite.T(), "Get", mock.Anything)
+
+	// test invalid parameters are rejected before querying
+	_, err = suite.vu.GetSmartList(1, entity.SmartListToday, 0, "Nowhere/City")
+	suite.Assert().ErrorIs(err, entity.ErrInvalidTimeZone)
+	_, err = suite.vu.GetSmartList(1, entity.SmartListUpcoming, 91, "UTC")
+	suite.Assert().ErrorIs(err, entity.ErrInvalidUpcomingDays)
+	suite.tr.AssertNumberOfCalls(suite.T(), "GetSmartList", 1)
+}
+
+func (suite *ViewUsecaseSuite) TestGetTasksAppliesSavedFilter() {
+	filter := &entity.TaskQuery{Statuses: []entity.StatusName{entity.Todo}, Inbox: true}
+	suite.svr.On("Get", entity.SavedViewID(3), entity.UserID(1)).Return(&entity.SavedView{ID: 3, Filter: filter, UserID: 1}, nil)
+	suite.tr.On("GetAll", entity.UserID(1), mock.MatchedBy(func(query *entity.TaskQuery) bool {
+		return query.Inbox && len(query.Statuses) == 1 &&
+			query.SortBy == entity.SortByPosition && query.Cursor == "next" && query.Limit == entity.MaxTaskLimit
+	})).Return(&entity.TaskPage{}, nil)
+
+	_, err := suite.vu.GetTasks(3, 1, "next", 1000)
+	suite.Assert().Nil(err)
+	suite.tr.AssertNumberOfCalls(suite.T(), "GetAll", 1)
+	// the saved filter itself is not changed
+	suite.Assert().Equal(&entity.TaskQuery{Statuses: []entity.StatusName{entity.Todo}, Inbox: true}, filter)
+}
+
+func (suite *ViewUsecaseSuite) TestGetTasksResolvesDueWithinDays() {
+	kiritimati, _ := time.LoadLocation("Pacific/Kiritimati")
+	days := 7
+	filter := &entity.TaskQuery{Priorities: []entity.Priority{entity.PriorityUrgent}, DueWithinDays: &days}
+	suite.svr.On("Get", entity.SavedViewID(3), entity.UserID(1)).Return(&entity.SavedView{ID: 3, Filter: filter, UserID: 1}, nil)
+	suite.ur.On("Get", entity.UserID(1)).Return(&entity.User{ID: 1, TimeZone: "Pacific/Kiritimati"}, nil)
+	// test the range starts today in the user's time zone
+	local := time.Now().In(kiritimati)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	suite.tr.On("GetAll", entity.UserID(1), mock.MatchedBy(func(query *entity.TaskQuery) bool {
+		return query.DeadlineFrom != nil && query.DeadlineFrom.Equal(today) &&
+			query.DeadlineTo != nil && query.DeadlineTo.Equal(today.AddDate(0, 0, 6)) &&
+			len(query.Priorities) == 1
+	})).Return(&entity.TaskPage{}, nil)
+
+	_, err := suite.vu.GetTasks(3, 1, "", 0)
+	suite.Assert().Nil(err)
+	suite.tr.AssertNumberOfCalls(suite.T(), "GetAll", 1)
+	// the saved filter keeps the relative range
+	suite.Assert().Nil(filter.DeadlineFrom)
+	suite.Assert().Nil(filter.DeadlineTo)
+}